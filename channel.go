@@ -0,0 +1,26 @@
+package iterable
+
+// FromChannel creates a new Iterable by receiving values from ch until it is closed.
+// It blocks until ch is closed, so the channel must be closed by its producer.
+func FromChannel[T comparable](ch <-chan T) *Iterable[T] {
+	received := make([]T, 0, len(ch))
+	for item := range ch {
+		received = append(received, item)
+	}
+
+	return Wrap(received)
+}
+
+// ToChannel returns a channel that yields every element of the collection in order
+// and is then closed. The channel is buffered to hold the whole collection, so no
+// goroutine is started and nothing leaks if the consumer stops receiving early.
+func (i *Iterable[T]) ToChannel() <-chan T {
+	ch := make(chan T, len(i.collection))
+	for _, item := range i.collection {
+		ch <- item
+	}
+
+	close(ch)
+
+	return ch
+}
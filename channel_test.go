@@ -0,0 +1,55 @@
+package iterable
+
+func (s *IterableSuite) TestFromChannel() {
+	s.Run("buffered channel", func() {
+		ch := make(chan int, 3)
+		ch <- 1
+		ch <- 2
+		ch <- 3
+		close(ch)
+
+		s.Equal([]int{1, 2, 3}, FromChannel(ch).Collect())
+	})
+
+	s.Run("closed empty channel", func() {
+		ch := make(chan int)
+		close(ch)
+
+		s.Equal([]int{}, FromChannel(ch).Collect())
+	})
+
+	s.Run("concurrent producer", func() {
+		ch := make(chan int)
+
+		go func() {
+			defer close(ch)
+
+			for i := range 5 {
+				ch <- i
+			}
+		}()
+
+		s.Equal([]int{0, 1, 2, 3, 4}, FromChannel(ch).Collect())
+	})
+}
+
+func (s *IterableSuite) TestToChannel() {
+	s.Run("drains in order", func() {
+		var received []string
+		for item := range New([]string{"a", "b", "c"}).ToChannel() {
+			received = append(received, item)
+		}
+
+		s.Equal([]string{"a", "b", "c"}, received)
+	})
+
+	s.Run("empty collection yields closed channel", func() {
+		_, ok := <-New([]int{}).ToChannel()
+		s.False(ok)
+	})
+
+	s.Run("round trip", func() {
+		input := []int{4, 5, 6}
+		s.Equal(input, FromChannel(New(input).ToChannel()).Collect())
+	})
+}
@@ -0,0 +1,61 @@
+package iterable
+
+import "context"
+
+// FilterCtx behaves like Filter but checks ctx before evaluating each element.
+// If ctx is cancelled, it stops early and returns ctx.Err(); elements that had not
+// been reached yet are kept unchecked after the elements kept before cancellation.
+// Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) FilterCtx(
+	ctx context.Context,
+	predicate func(item T) bool,
+) (*Iterable[T], error) {
+	filtered := make([]T, 0, len(i.collection))
+	for idx, item := range i.collection {
+		if err := ctx.Err(); err != nil {
+			i.collection = append(filtered, i.collection[idx:]...)
+
+			return i, err
+		}
+
+		if predicate(item) {
+			filtered = append(filtered, item)
+		}
+	}
+
+	i.collection = filtered
+
+	return i, nil
+}
+
+// MutateCtx behaves like Mutate but checks ctx before mutating each element.
+// If ctx is cancelled, it stops early and returns ctx.Err(); elements that had not
+// been reached yet are left unchanged.
+// Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) MutateCtx(ctx context.Context, mutate func(item *T)) (*Iterable[T], error) {
+	for idx := range i.collection {
+		if err := ctx.Err(); err != nil {
+			return i, err
+		}
+
+		mutate(&i.collection[idx])
+	}
+
+	return i, nil
+}
+
+// ForEachCtx behaves like ForEach but checks ctx before visiting each element.
+// If ctx is cancelled, it stops early and returns ctx.Err(); elements that had not
+// been reached yet are not visited.
+// Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) ForEachCtx(ctx context.Context, fn func(item T)) (*Iterable[T], error) {
+	for _, item := range i.collection {
+		if err := ctx.Err(); err != nil {
+			return i, err
+		}
+
+		fn(item)
+	}
+
+	return i, nil
+}
@@ -0,0 +1,92 @@
+package iterable
+
+import "context"
+
+func (s *IterableSuite) TestFilterCtx() {
+	s.Run("completes without cancellation", func() {
+		iter, err := New([]int{1, 2, 3, 4}).FilterCtx(context.Background(), func(i int) bool {
+			return i%2 == 0
+		})
+		s.Require().NoError(err)
+		s.Equal([]int{2, 4}, iter.Collect())
+	})
+
+	s.Run("cancelled partway", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		calls := 0
+		iter, err := New([]int{2, 4, 5, 6, 8, 10}).FilterCtx(ctx, func(i int) bool {
+			calls++
+			if i == 6 {
+				cancel()
+			}
+
+			return i%2 == 0
+		})
+		s.Require().ErrorIs(err, context.Canceled)
+		s.Equal(4, calls)
+		s.Equal([]int{2, 4, 6, 8, 10}, iter.Collect())
+	})
+
+	s.Run("already cancelled", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		iter, err := New([]int{1, 2, 3}).FilterCtx(ctx, func(int) bool { return false })
+		s.Require().ErrorIs(err, context.Canceled)
+		s.Equal([]int{1, 2, 3}, iter.Collect())
+	})
+}
+
+func (s *IterableSuite) TestMutateCtx() {
+	s.Run("completes without cancellation", func() {
+		iter, err := New([]int{1, 2, 3}).MutateCtx(context.Background(), func(i *int) { *i *= 2 })
+		s.Require().NoError(err)
+		s.Equal([]int{2, 4, 6}, iter.Collect())
+	})
+
+	s.Run("cancelled partway", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		iter, err := New([]int{1, 2, 3, 4}).MutateCtx(ctx, func(i *int) {
+			if *i == 2 {
+				cancel()
+			}
+
+			*i *= 10
+		})
+		s.Require().ErrorIs(err, context.Canceled)
+		s.Equal([]int{10, 20, 3, 4}, iter.Collect())
+	})
+}
+
+func (s *IterableSuite) TestForEachCtx() {
+	s.Run("completes without cancellation", func() {
+		var visited []int
+
+		_, err := New([]int{1, 2, 3}).ForEachCtx(context.Background(), func(i int) {
+			visited = append(visited, i)
+		})
+		s.Require().NoError(err)
+		s.Equal([]int{1, 2, 3}, visited)
+	})
+
+	s.Run("cancelled partway", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var visited []int
+
+		iter, err := New([]int{1, 2, 3, 4}).ForEachCtx(ctx, func(i int) {
+			visited = append(visited, i)
+			if i == 2 {
+				cancel()
+			}
+		})
+		s.Require().ErrorIs(err, context.Canceled)
+		s.Equal([]int{1, 2}, visited)
+		s.Equal([]int{1, 2, 3, 4}, iter.Collect())
+	})
+}
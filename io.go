@@ -0,0 +1,26 @@
+package iterable
+
+import (
+	"bufio"
+	"io"
+)
+
+// FromLines creates a new Iterable holding the lines read from r, without their
+// line terminators. A trailing newline at the end of the input does not produce an
+// extra empty line. If reading fails, FromLines returns a nil Iterable and the error.
+// Lines are read with a bufio.Scanner, so a line longer than bufio.MaxScanTokenSize
+// (64 KiB) makes FromLines fail with bufio.ErrTooLong.
+func FromLines(r io.Reader) (*Iterable[string], error) {
+	lines := make([]string, 0)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return Wrap(lines), nil
+}
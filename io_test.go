@@ -0,0 +1,75 @@
+package iterable
+
+import (
+	"bufio"
+	"errors"
+	"strings"
+	"testing/iotest"
+)
+
+func (s *IterableSuite) TestFromLines() {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:     "empty input",
+			input:    "",
+			expected: []string{},
+		},
+		{
+			name:     "single line without newline",
+			input:    "hello",
+			expected: []string{"hello"},
+		},
+		{
+			name:     "trailing newline",
+			input:    "hello\nworld\n",
+			expected: []string{"hello", "world"},
+		},
+		{
+			name:     "blank lines are kept",
+			input:    "a\n\nb",
+			expected: []string{"a", "", "b"},
+		},
+		{
+			name:     "windows line endings",
+			input:    "a\r\nb\r\n",
+			expected: []string{"a", "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			iter, err := FromLines(strings.NewReader(tt.input))
+			s.Require().NoError(err)
+			s.Equal(tt.expected, iter.Collect())
+		})
+	}
+
+	s.Run("read error", func() {
+		errRead := errors.New("read failed")
+
+		iter, err := FromLines(iotest.ErrReader(errRead))
+		s.Require().ErrorIs(err, errRead)
+		s.Nil(iter)
+	})
+
+	s.Run("line longer than the token limit", func() {
+		long := strings.Repeat("x", bufio.MaxScanTokenSize+1)
+
+		iter, err := FromLines(strings.NewReader("short\n" + long + "\n"))
+		s.Require().ErrorIs(err, bufio.ErrTooLong)
+		s.Nil(iter)
+	})
+
+	s.Run("pipeline", func() {
+		iter, err := FromLines(strings.NewReader("INFO start\nERROR disk\nINFO done\nERROR net\n"))
+		s.Require().NoError(err)
+
+		result := iter.Filter(func(line string) bool { return strings.HasPrefix(line, "ERROR") }).
+			Collect()
+		s.Equal([]string{"ERROR disk", "ERROR net"}, result)
+	})
+}
@@ -4,18 +4,123 @@
 package iterable
 
 import (
+	"cmp"
+	"container/heap"
+	"errors"
+	"fmt"
+	"iter"
+	"maps"
+	"math/rand/v2"
 	"slices"
+	"strings"
 )
 
+// ErrInvalidBatchSize is returned by Batch when the requested batch size is not positive.
+var ErrInvalidBatchSize = errors.New("iterable: batch size must be positive")
+
 // New creates a new Iterable instance from a slice of comparable elements.
 // It serves as the entry point for creating chainable slice operations.
+// The slice is copied, so operations on the Iterable never modify the caller's slice.
 func New[T comparable](collection []T) *Iterable[T] {
+	return Wrap(slices.Clone(collection))
+}
+
+// Wrap creates a new Iterable instance that uses the given slice directly without
+// copying it. It avoids the allocation made by New, but in-place operations such as
+// Mutate, Reverse or SortFunc will modify the caller's slice.
+func Wrap[T comparable](collection []T) *Iterable[T] {
 	return &Iterable[T]{collection: collection}
 }
 
+// FromSeq creates a new Iterable by collecting all values yielded by seq. An empty
+// sequence produces an empty, non-nil collection.
+func FromSeq[T comparable](seq iter.Seq[T]) *Iterable[T] {
+	return Wrap(slices.AppendSeq([]T{}, seq))
+}
+
+// Repeat creates a new Iterable containing n copies of value.
+// If n is not positive, the Iterable is empty.
+func Repeat[T comparable](value T, n int) *Iterable[T] {
+	repeated := make([]T, max(n, 0))
+	for idx := range repeated {
+		repeated[idx] = value
+	}
+
+	return Wrap(repeated)
+}
+
+// Range creates a new Iterable holding the arithmetic sequence that starts at start
+// and advances by step while staying below end, or above end for a negative step.
+// The Iterable is empty if step is zero or the sequence would contain no elements.
+// The length is computed with unsigned arithmetic, so steps that would overflow int
+// when added to the last element still end the sequence correctly.
+func Range(start, end, step int) *Iterable[int] {
+	var length uint
+
+	switch {
+	case step > 0 && start < end:
+		length = (uint(end-start)-1)/uint(step) + 1
+	case step < 0 && start > end:
+		length = (uint(start-end)-1)/uint(-step) + 1
+	}
+
+	sequence := make([]int, length)
+	for idx := range sequence {
+		sequence[idx] = start + idx*step
+	}
+
+	return Wrap(sequence)
+}
+
+// Generate creates a new Iterable of n elements by calling fn with each index from
+// 0 to n-1. If n is not positive, the Iterable is empty.
+func Generate[T comparable](n int, fn func(index int) T) *Iterable[T] {
+	generated := make([]T, max(n, 0))
+	for idx := range generated {
+		generated[idx] = fn(idx)
+	}
+
+	return Wrap(generated)
+}
+
+// Iterate creates a new Iterable of n elements that starts with seed, where every
+// following element is the result of applying fn to the previous one. If n is not
+// positive, the Iterable is empty.
+func Iterate[T comparable](seed T, n int, fn func(prev T) T) *Iterable[T] {
+	iterated := make([]T, max(n, 0))
+	for idx := range iterated {
+		if idx == 0 {
+			iterated[idx] = seed
+		} else {
+			iterated[idx] = fn(iterated[idx-1])
+		}
+	}
+
+	return Wrap(iterated)
+}
+
+// Keys creates a new Iterable holding the keys of m. Map iteration order is
+// unspecified, so the order of the keys is too; use SortedKeys for a stable order.
+func Keys[K comparable, V any](m map[K]V) *Iterable[K] {
+	return FromSeq(maps.Keys(m))
+}
+
+// SortedKeys creates a new Iterable holding the keys of m in ascending order.
+func SortedKeys[K cmp.Ordered, V any](m map[K]V) *Iterable[K] {
+	return Sort(Keys(m))
+}
+
+// Values creates a new Iterable holding the values of m. Map iteration order is
+// unspecified, so the order of the values is too.
+func Values[K comparable, V comparable](m map[K]V) *Iterable[V] {
+	return FromSeq(maps.Values(m))
+}
+
 // Iterable represents a wrapper around a slice that provides chainable operations.
 // The type parameter T must satisfy the comparable constraint to ensure elements
-// can be compared for equality.
+// can be compared for equality. Slices are not comparable, so operations that
+// produce or consume groups of elements, such as Chunk or Flatten, use a plain
+// [][]T rather than an Iterable of slices.
 type Iterable[T comparable] struct {
 	collection []T
 }
@@ -23,14 +128,43 @@ type Iterable[T comparable] struct {
 // Filter removes elements from the collection that don't satisfy the predicate function.
 // It returns the same Iterable instance to enable method chaining.
 // The predicate function should return true for elements that should be kept.
+// The kept elements are collected into a new slice, so the slice the collection
+// was created from is never modified.
 func (i *Iterable[T]) Filter(predicate func(item T) bool) *Iterable[T] {
-	i.collection = slices.DeleteFunc(i.collection, func(e T) bool {
-		return !predicate(e)
-	})
+	filtered := make([]T, 0, len(i.collection))
+	for _, item := range i.collection {
+		if predicate(item) {
+			filtered = append(filtered, item)
+		}
+	}
+
+	i.collection = filtered
+
+	return i
+}
+
+// FilterWithIndex is like Filter but also passes each element's 0-based position to
+// the predicate. Positions refer to the collection before any elements are removed.
+func (i *Iterable[T]) FilterWithIndex(predicate func(index int, item T) bool) *Iterable[T] {
+	filtered := make([]T, 0, len(i.collection))
+	for idx, item := range i.collection {
+		if predicate(idx, item) {
+			filtered = append(filtered, item)
+		}
+	}
+
+	i.collection = filtered
 
 	return i
 }
 
+// Reject removes elements from the collection that satisfy the predicate function.
+// It is the complement of Filter and returns the same Iterable instance to enable
+// method chaining.
+func (i *Iterable[T]) Reject(predicate func(item T) bool) *Iterable[T] {
+	return i.Filter(func(item T) bool { return !predicate(item) })
+}
+
 // Mutate applies a mutation function to each element in the collection.
 // The mutation function receives a pointer to each element, allowing it to modify
 // the element in place. Returns the same Iterable instance to enable method chaining.
@@ -42,6 +176,47 @@ func (i *Iterable[T]) Mutate(mutate func(item *T)) *Iterable[T] {
 	return i
 }
 
+// MutateCopy is like Mutate but first copies the collection, so the mutation never
+// reaches a slice shared with the caller, such as one passed to Wrap. Returns the
+// same Iterable instance to enable method chaining.
+func (i *Iterable[T]) MutateCopy(mutate func(item *T)) *Iterable[T] {
+	i.collection = slices.Clone(i.collection)
+
+	return i.Mutate(mutate)
+}
+
+// ForEach calls fn for each element in the collection in order. Each element is
+// passed by value, so fn cannot modify the collection. Returns the same Iterable
+// instance to enable method chaining.
+func (i *Iterable[T]) ForEach(fn func(item T)) *Iterable[T] {
+	for _, item := range i.collection {
+		fn(item)
+	}
+
+	return i
+}
+
+// ForEachWhile calls fn for each element in the collection in order until fn returns
+// false, after which the remaining elements are skipped. Returns the same Iterable
+// instance to enable method chaining.
+func (i *Iterable[T]) ForEachWhile(fn func(item T) bool) *Iterable[T] {
+	for _, item := range i.collection {
+		if !fn(item) {
+			break
+		}
+	}
+
+	return i
+}
+
+// Tap calls fn for each element in the collection to observe the pipeline at this
+// point, for example for logging or metrics, and leaves the collection unchanged.
+// Each element is passed by value. Returns the same Iterable instance to enable
+// method chaining.
+func (i *Iterable[T]) Tap(fn func(item T)) *Iterable[T] {
+	return i.ForEach(fn)
+}
+
 // Unique removes duplicate elements from the collection, keeping only the first
 // occurrence of each unique element. The order of remaining elements is preserved.
 // Returns the same Iterable instance to enable method chaining.
@@ -62,6 +237,321 @@ func (i *Iterable[T]) Unique() *Iterable[T] {
 	return i
 }
 
+// Reverse reverses the order of elements in the collection in place.
+// Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) Reverse() *Iterable[T] {
+	slices.Reverse(i.collection)
+
+	return i
+}
+
+// SortFunc sorts the collection in place using the provided comparison function,
+// which should return a negative number when a < b, a positive number when a > b
+// and zero when they are equal. The sort is not guaranteed to be stable.
+// Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) SortFunc(cmp func(a, b T) int) *Iterable[T] {
+	slices.SortFunc(i.collection, cmp)
+
+	return i
+}
+
+// SortStableFunc sorts the collection in place using the provided comparison function
+// while keeping the original order of equal elements.
+// Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) SortStableFunc(cmp func(a, b T) int) *Iterable[T] {
+	slices.SortStableFunc(i.collection, cmp)
+
+	return i
+}
+
+// Take keeps only the first n elements of the collection. If n exceeds the number
+// of elements, the whole collection is kept; a negative n is treated as 0.
+// Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) Take(n int) *Iterable[T] {
+	i.collection = i.collection[:clamp(n, 0, len(i.collection))]
+
+	return i
+}
+
+// Drop discards the first n elements of the collection. If n exceeds the number
+// of elements, the collection becomes empty; a negative n is treated as 0.
+// Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) Drop(n int) *Iterable[T] {
+	i.collection = i.collection[clamp(n, 0, len(i.collection)):]
+
+	return i
+}
+
+// TakeWhile keeps the leading elements of the collection for as long as the predicate
+// function returns true. Once the predicate returns false, that element and all
+// following elements are discarded, even if some of them would match.
+// Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) TakeWhile(predicate func(item T) bool) *Iterable[T] {
+	return i.Take(i.leadingRun(predicate))
+}
+
+// DropWhile discards the leading elements of the collection for as long as the
+// predicate function returns true and keeps the remaining elements.
+// Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) DropWhile(predicate func(item T) bool) *Iterable[T] {
+	return i.Drop(i.leadingRun(predicate))
+}
+
+// leadingRun returns the length of the leading run of elements that satisfy the
+// predicate function.
+func (i *Iterable[T]) leadingRun(predicate func(item T) bool) int {
+	for idx, item := range i.collection {
+		if !predicate(item) {
+			return idx
+		}
+	}
+
+	return len(i.collection)
+}
+
+// Concat appends the elements of other to the end of the collection. The elements
+// are copied, so the collection never shares a backing array with other.
+// Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) Concat(other *Iterable[T]) *Iterable[T] {
+	return i.Append(other.collection...)
+}
+
+// Append adds the given items to the end of the collection.
+// Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) Append(items ...T) *Iterable[T] {
+	i.collection = append(i.collection, items...)
+
+	return i
+}
+
+// Prepend inserts the given items at the front of the collection, keeping them in
+// the order they were given. Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) Prepend(items ...T) *Iterable[T] {
+	i.collection = slices.Insert(i.collection, 0, items...)
+
+	return i
+}
+
+// UniqueFunc removes elements whose key, as returned by keyFn, has already been seen,
+// keeping only the first occurrence for each key. It is the method form of DistinctBy;
+// the keys must hold comparable values, otherwise UniqueFunc panics.
+// Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) UniqueFunc(keyFn func(item T) any) *Iterable[T] {
+	return DistinctBy(i, keyFn)
+}
+
+// DedupeConsecutive collapses runs of adjacent equal elements into a single element,
+// like the Unix uniq command. Unlike Unique, equal elements that are not adjacent
+// are kept. Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) DedupeConsecutive() *Iterable[T] {
+	i.collection = slices.Compact(i.collection)
+
+	return i
+}
+
+// Slice keeps only the elements from index start up to but not including index end.
+// Both bounds are clamped to the range [0, Len()], and a start greater than end
+// results in an empty collection instead of a panic.
+// Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) Slice(start, end int) *Iterable[T] {
+	start = clamp(start, 0, len(i.collection))
+	end = clamp(end, start, len(i.collection))
+	i.collection = i.collection[start:end]
+
+	return i
+}
+
+// Clear removes all elements from the collection while keeping its capacity, so the
+// Iterable can be refilled with Append without reallocating.
+// Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) Clear() *Iterable[T] {
+	i.collection = i.collection[:0]
+
+	return i
+}
+
+// Remove removes every occurrence of target from the collection.
+// Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) Remove(target T) *Iterable[T] {
+	return i.Filter(func(item T) bool {
+		return item != target
+	})
+}
+
+// RemoveAt removes the element at the given index, shifting later elements left.
+// An out-of-range index, including a negative one, leaves the collection unchanged.
+// Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) RemoveAt(index int) *Iterable[T] {
+	if index >= 0 && index < len(i.collection) {
+		i.collection = slices.Delete(i.collection, index, index+1)
+	}
+
+	return i
+}
+
+// Insert inserts the given items at the given index, shifting later elements right.
+// An index equal to Len appends the items. The index is clamped to the range
+// [0, Len()], so a negative index inserts at the front and an index beyond Len
+// appends. Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) Insert(index int, items ...T) *Iterable[T] {
+	i.collection = slices.Insert(i.collection, clamp(index, 0, len(i.collection)), items...)
+
+	return i
+}
+
+// Fill overwrites every element in the collection with value.
+// Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) Fill(value T) *Iterable[T] {
+	for idx := range i.collection {
+		i.collection[idx] = value
+	}
+
+	return i
+}
+
+// Replace overwrites every occurrence of oldValue in the collection with newValue.
+// Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) Replace(oldValue, newValue T) *Iterable[T] {
+	return i.ReplaceFunc(func(item T) bool { return item == oldValue }, newValue)
+}
+
+// ReplaceFunc overwrites every element that satisfies the predicate function with
+// newValue. Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) ReplaceFunc(predicate func(item T) bool, newValue T) *Iterable[T] {
+	for idx, item := range i.collection {
+		if predicate(item) {
+			i.collection[idx] = newValue
+		}
+	}
+
+	return i
+}
+
+// Intersperse inserts sep between each pair of adjacent elements, but not before the
+// first or after the last one. Collections with fewer than two elements are left
+// unchanged. Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) Intersperse(sep T) *Iterable[T] {
+	if len(i.collection) < 2 {
+		return i
+	}
+
+	interspersed := make([]T, 0, 2*len(i.collection)-1)
+	for idx, item := range i.collection {
+		if idx > 0 {
+			interspersed = append(interspersed, sep)
+		}
+
+		interspersed = append(interspersed, item)
+	}
+
+	i.collection = interspersed
+
+	return i
+}
+
+// Cycle replaces the collection with times back-to-back copies of itself. A times of
+// zero or less empties the collection. Returns the same Iterable instance to enable
+// method chaining.
+func (i *Iterable[T]) Cycle(times int) *Iterable[T] {
+	i.collection = slices.Repeat(i.collection, max(times, 0))
+
+	return i
+}
+
+// PadRight appends fill to the end of the collection until it holds length elements.
+// Collections already at least length long are left unchanged.
+// Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) PadRight(length int, fill T) *Iterable[T] {
+	if missing := length - len(i.collection); missing > 0 {
+		i.collection = append(i.collection, Repeat(fill, missing).collection...)
+	}
+
+	return i
+}
+
+// PadLeft prepends fill to the start of the collection until it holds length elements.
+// Collections already at least length long are left unchanged.
+// Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) PadLeft(length int, fill T) *Iterable[T] {
+	if missing := length - len(i.collection); missing > 0 {
+		i.collection = append(Repeat(fill, missing).collection, i.collection...)
+	}
+
+	return i
+}
+
+// Rotate shifts the elements of the collection circularly to the left by n positions,
+// so the element at index n becomes the first one. A negative n rotates to the right.
+// n is taken modulo the number of elements, so rotating by a multiple of Len leaves
+// the collection unchanged. Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) Rotate(n int) *Iterable[T] {
+	if len(i.collection) == 0 {
+		return i
+	}
+
+	shift := (n%len(i.collection) + len(i.collection)) % len(i.collection)
+	slices.Reverse(i.collection[:shift])
+	slices.Reverse(i.collection[shift:])
+	slices.Reverse(i.collection)
+
+	return i
+}
+
+// Shuffle randomly permutes the elements of the collection in place using r as the
+// source of randomness. Passing a seeded source makes the permutation reproducible;
+// a nil r uses the default source of the math/rand/v2 package.
+// Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) Shuffle(r *rand.Rand) *Iterable[T] {
+	swap := func(a, b int) {
+		i.collection[a], i.collection[b] = i.collection[b], i.collection[a]
+	}
+
+	if r == nil {
+		rand.Shuffle(len(i.collection), swap)
+	} else {
+		r.Shuffle(len(i.collection), swap)
+	}
+
+	return i
+}
+
+// Sample keeps k elements of the collection chosen uniformly at random without
+// replacement, using reservoir sampling with r as the source of randomness. If k is
+// at least Len, every element is kept in its original order; if k is not positive,
+// the collection becomes empty. A nil r uses the default source of the math/rand/v2
+// package. Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) Sample(k int, r *rand.Rand) *Iterable[T] {
+	if k >= len(i.collection) {
+		return i
+	}
+
+	intN := rand.IntN
+	if r != nil {
+		intN = r.IntN
+	}
+
+	reservoir := make([]T, max(k, 0))
+	copy(reservoir, i.collection)
+
+	for idx := len(reservoir); idx < len(i.collection); idx++ {
+		if j := intN(idx + 1); j < len(reservoir) {
+			reservoir[j] = i.collection[idx]
+		}
+	}
+
+	i.collection = reservoir
+
+	return i
+}
+
+// Compact removes every element equal to the zero value of T, such as 0, "" or nil.
+// Returns the same Iterable instance to enable method chaining.
+func (i *Iterable[T]) Compact() *Iterable[T] {
+	var zero T
+
+	return i.Remove(zero)
+}
+
 // Collect returns the underlying slice containing all elements in the collection.
 // This method is typically used at the end of a chain of operations to obtain
 // the final result as a standard slice.
@@ -69,6 +559,20 @@ func (i *Iterable[T]) Collect() []T {
 	return i.collection
 }
 
+// CollectInto appends all elements in the collection to dst and returns the extended
+// slice, following the semantics of the built-in append. Passing a reused buffer such
+// as buf[:0] lets callers avoid allocating a new slice on every call.
+func (i *Iterable[T]) CollectInto(dst []T) []T {
+	return append(dst, i.collection...)
+}
+
+// CollectN returns at most the first n elements of the collection as a new slice, so
+// modifying it does not affect the Iterable. A non-positive n returns an empty slice,
+// and an n larger than the collection returns every element.
+func (i *Iterable[T]) CollectN(n int) []T {
+	return append([]T{}, i.collection[:clamp(n, 0, len(i.collection))]...)
+}
+
 // Len returns the current number of elements in the collection.
 // This method is useful for getting the size of the collection after
 // filtering or other operations that may modify its length.
@@ -76,14 +580,1110 @@ func (i *Iterable[T]) Len() int {
 	return len(i.collection)
 }
 
-// Map creates a new Iterable by transforming each element in the source Iterable
-// using the provided mapper function. The mapper function converts elements of type T
-// to elements of type U, where both types must satisfy the comparable constraint.
-func Map[T comparable, U comparable](iter *Iterable[T], mapper func(item T) U) *Iterable[U] {
-	mapped := make([]U, 0, iter.Len())
-	for _, item := range iter.Collect() {
-		mapped = append(mapped, mapper(item))
+// Count returns the number of elements in the collection. It is equivalent to Len
+// and exists to make the intent of terminal counting operations explicit.
+func (i *Iterable[T]) Count() int {
+	return i.Len()
+}
+
+// CountFunc returns the number of elements that satisfy the predicate function.
+// Unlike Filter, it does not allocate or modify the collection.
+func (i *Iterable[T]) CountFunc(predicate func(item T) bool) int {
+	count := 0
+
+	for _, item := range i.collection {
+		if predicate(item) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Any reports whether at least one element satisfies the predicate function.
+// Iteration stops at the first matching element. Returns false for an empty collection.
+func (i *Iterable[T]) Any(predicate func(item T) bool) bool {
+	for _, item := range i.collection {
+		if predicate(item) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// All reports whether every element satisfies the predicate function.
+// Iteration stops at the first element that does not match. Returns true for an
+// empty collection.
+func (i *Iterable[T]) All(predicate func(item T) bool) bool {
+	for _, item := range i.collection {
+		if !predicate(item) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// None reports whether no element satisfies the predicate function. It is the
+// negation of Any and stops at the first matching element.
+func (i *Iterable[T]) None(predicate func(item T) bool) bool {
+	return !i.Any(predicate)
+}
+
+// Contains reports whether target is present in the collection.
+func (i *Iterable[T]) Contains(target T) bool {
+	return slices.Contains(i.collection, target)
+}
+
+// IndexOf returns the index of the first occurrence of target in the collection,
+// or -1 if it is not present.
+func (i *Iterable[T]) IndexOf(target T) int {
+	return slices.Index(i.collection, target)
+}
+
+// LastIndexOf returns the index of the last occurrence of target in the collection,
+// or -1 if it is not present.
+func (i *Iterable[T]) LastIndexOf(target T) int {
+	for idx := len(i.collection) - 1; idx >= 0; idx-- {
+		if i.collection[idx] == target {
+			return idx
+		}
+	}
+
+	return -1
+}
+
+// IsSortedFunc reports whether the collection is sorted in ascending order of the
+// comparison function. Empty and single-element collections are always sorted.
+func (i *Iterable[T]) IsSortedFunc(cmp func(a, b T) int) bool {
+	return slices.IsSortedFunc(i.collection, cmp)
+}
+
+// BinarySearchFunc searches for target in a collection sorted in ascending order of the
+// comparison function, as slices.BinarySearchFunc does. It returns the index at which
+// target was found, or the index at which it would be inserted, and whether it was found.
+func (i *Iterable[T]) BinarySearchFunc(target T, cmp func(a, b T) int) (int, bool) {
+	return slices.BinarySearchFunc(i.collection, target, cmp)
+}
+
+// Find returns the first element that satisfies the predicate function and true.
+// If no element matches, it returns the zero value of T and false. Iteration stops
+// at the first match.
+func (i *Iterable[T]) Find(predicate func(item T) bool) (T, bool) {
+	idx := i.FindIndex(predicate)
+	if idx < 0 {
+		var zero T
+
+		return zero, false
+	}
+
+	return i.collection[idx], true
+}
+
+// FindIndex returns the index of the first element that satisfies the predicate
+// function, or -1 if no element matches. Iteration stops at the first match.
+func (i *Iterable[T]) FindIndex(predicate func(item T) bool) int {
+	return slices.IndexFunc(i.collection, predicate)
+}
+
+// MinFunc returns the minimal element according to the comparison function and true.
+// If several elements are minimal, the first one is returned. For an empty collection
+// it returns the zero value of T and false. The collection is not modified.
+func (i *Iterable[T]) MinFunc(cmp func(a, b T) int) (T, bool) {
+	if len(i.collection) == 0 {
+		var zero T
+
+		return zero, false
+	}
+
+	return slices.MinFunc(i.collection, cmp), true
+}
+
+// MaxFunc returns the maximal element according to the comparison function and true.
+// If several elements are maximal, the first one is returned. For an empty collection
+// it returns the zero value of T and false. The collection is not modified.
+func (i *Iterable[T]) MaxFunc(cmp func(a, b T) int) (T, bool) {
+	if len(i.collection) == 0 {
+		var zero T
+
+		return zero, false
+	}
+
+	return slices.MaxFunc(i.collection, cmp), true
+}
+
+// Partition splits the collection in a single pass into two new Iterables: one with
+// the elements that satisfy the predicate function and one with the elements that
+// don't. The relative order of elements is preserved within each Iterable and the
+// receiver's collection is not modified.
+func (i *Iterable[T]) Partition(predicate func(item T) bool) (*Iterable[T], *Iterable[T]) {
+	matched := make([]T, 0, len(i.collection))
+	rest := make([]T, 0, len(i.collection))
+
+	for _, item := range i.collection {
+		if predicate(item) {
+			matched = append(matched, item)
+		} else {
+			rest = append(rest, item)
+		}
+	}
+
+	return Wrap(matched), Wrap(rest)
+}
+
+// Seq returns an iterator over the elements of the collection in order, suitable
+// for use in a range loop or with standard library functions accepting an iter.Seq.
+func (i *Iterable[T]) Seq() iter.Seq[T] {
+	return slices.Values(i.collection)
+}
+
+// Seq2 returns an iterator over the index and value pairs of the collection in order.
+// Iteration stops as soon as the consumer stops ranging.
+func (i *Iterable[T]) Seq2() iter.Seq2[int, T] {
+	return slices.All(i.collection)
+}
+
+// At returns the element at the given index and true. A negative index counts back
+// from the end of the collection, so -1 refers to the last element. If the index is
+// out of range, At returns the zero value of T and false instead of panicking.
+func (i *Iterable[T]) At(index int) (T, bool) {
+	if index < 0 {
+		index += len(i.collection)
+	}
+
+	if index < 0 || index >= len(i.collection) {
+		var zero T
+
+		return zero, false
+	}
+
+	return i.collection[index], true
+}
+
+// First returns the first element of the collection and true, or the zero value
+// of T and false if the collection is empty.
+func (i *Iterable[T]) First() (T, bool) {
+	return i.At(0)
+}
+
+// Last returns the last element of the collection and true, or the zero value
+// of T and false if the collection is empty.
+func (i *Iterable[T]) Last() (T, bool) {
+	return i.At(-1)
+}
+
+// Clone returns a new Iterable with its own copy of the collection, so in-place
+// operations on either Iterable do not affect the other.
+func (i *Iterable[T]) Clone() *Iterable[T] {
+	return New(i.collection)
+}
+
+// Tee returns two new Iterables, each with its own copy of the collection, so the
+// same elements can be transformed independently by two consumers.
+func (i *Iterable[T]) Tee() (*Iterable[T], *Iterable[T]) {
+	return i.Clone(), i.Clone()
+}
+
+// IsEmpty reports whether the collection contains no elements.
+func (i *Iterable[T]) IsEmpty() bool {
+	return len(i.collection) == 0
+}
+
+// TryForEach calls fn for each element in the collection in order and stops at the
+// first non-nil error, which it returns. It returns nil if every call succeeds.
+func (i *Iterable[T]) TryForEach(fn func(item T) error) error {
+	for _, item := range i.collection {
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// TryForEachAll calls fn for every element in the collection, even after a call
+// fails, and returns all non-nil errors combined with errors.Join. It returns nil
+// if every call succeeds.
+func (i *Iterable[T]) TryForEachAll(fn func(item T) error) error {
+	errs := make([]error, 0, len(i.collection))
+	for _, item := range i.collection {
+		errs = append(errs, fn(item))
+	}
+
+	return errors.Join(errs...)
+}
+
+// String returns the collection formatted like a slice, such as [a b c], using the
+// default fmt formatting for each element. An empty collection is rendered as [].
+func (i *Iterable[T]) String() string {
+	return fmt.Sprint(i.collection)
+}
+
+// Equal reports whether both collections have the same length and equal elements
+// in the same order.
+func (i *Iterable[T]) Equal(other *Iterable[T]) bool {
+	return slices.Equal(i.collection, other.collection)
+}
+
+// EqualUnordered reports whether both collections contain the same elements with the
+// same number of occurrences, regardless of their order.
+func (i *Iterable[T]) EqualUnordered(other *Iterable[T]) bool {
+	if len(i.collection) != len(other.collection) {
+		return false
+	}
+
+	counts := make(map[T]int, len(i.collection))
+	for _, item := range i.collection {
+		counts[item]++
+	}
+
+	for _, item := range other.collection {
+		if counts[item] == 0 {
+			return false
+		}
+
+		counts[item]--
+	}
+
+	return true
+}
+
+// Batch calls fn with consecutive batches of at most size elements, in order, and
+// stops at the first non-nil error, which it returns. Every batch has exactly size
+// elements except possibly the last one. The batches share memory with the collection,
+// so fn must not retain them beyond the call if the collection is modified later.
+// Batch returns ErrInvalidBatchSize if size is not positive.
+func (i *Iterable[T]) Batch(size int, fn func(batch []T) error) error {
+	if size <= 0 {
+		return ErrInvalidBatchSize
+	}
+
+	for start := 0; start < len(i.collection); start += size {
+		end := min(start+size, len(i.collection))
+		if err := fn(i.collection[start:end:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Frequencies returns how many times each distinct element occurs in the collection.
+func (i *Iterable[T]) Frequencies() map[T]int {
+	return CountBy(i, func(item T) T { return item })
+}
+
+// SeqReversed returns an iterator over the elements of the collection from back to
+// front, without copying or modifying the collection. Iteration stops as soon as the
+// consumer stops ranging.
+func (i *Iterable[T]) SeqReversed() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range slices.Backward(i.collection) {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Map creates a new Iterable by transforming each element in the source Iterable
+// using the provided mapper function. The mapper function converts elements of type T
+// to elements of type U, where both types must satisfy the comparable constraint.
+func Map[T comparable, U comparable](iter *Iterable[T], mapper func(item T) U) *Iterable[U] {
+	mapped := make([]U, 0, iter.Len())
+	for _, item := range iter.Collect() {
+		mapped = append(mapped, mapper(item))
+	}
+
+	return Wrap(mapped)
+}
+
+// MapWithIndex is like Map but also passes each element's 0-based position in the
+// collection to the mapper.
+func MapWithIndex[T comparable, U comparable](
+	iter *Iterable[T],
+	mapper func(index int, item T) U,
+) *Iterable[U] {
+	mapped := make([]U, 0, iter.Len())
+	for idx, item := range iter.Collect() {
+		mapped = append(mapped, mapper(idx, item))
+	}
+
+	return Wrap(mapped)
+}
+
+// FlatMap creates a new Iterable by transforming each element in the source Iterable
+// into a slice of elements of type U and concatenating the results in order.
+// Elements mapped to an empty or nil slice contribute nothing to the result.
+func FlatMap[T comparable, U comparable](iter *Iterable[T], mapper func(item T) []U) *Iterable[U] {
+	flattened := make([]U, 0, iter.Len())
+	for _, item := range iter.Collect() {
+		flattened = append(flattened, mapper(item)...)
+	}
+
+	return Wrap(flattened)
+}
+
+// FilterMap creates a new Iterable by transforming and filtering the source Iterable in
+// a single pass. The function returns the mapped value and whether to keep it; only
+// kept values appear in the result, in their original order.
+func FilterMap[T comparable, U comparable](
+	iter *Iterable[T],
+	fn func(item T) (U, bool),
+) *Iterable[U] {
+	mapped := make([]U, 0, iter.Len())
+	for _, item := range iter.Collect() {
+		if result, keep := fn(item); keep {
+			mapped = append(mapped, result)
+		}
+	}
+
+	return Wrap(mapped)
+}
+
+// TryMap creates a new Iterable by transforming each element in the source Iterable
+// using a mapper function that may fail. It stops at the first error and returns it
+// with a nil Iterable; otherwise it returns the mapped Iterable and a nil error.
+func TryMap[T comparable, U comparable](
+	iter *Iterable[T],
+	mapper func(item T) (U, error),
+) (*Iterable[U], error) {
+	mapped := make([]U, 0, iter.Len())
+	for _, item := range iter.Collect() {
+		result, err := mapper(item)
+		if err != nil {
+			return nil, err
+		}
+
+		mapped = append(mapped, result)
+	}
+
+	return Wrap(mapped), nil
+}
+
+// MapErr creates a new Iterable by transforming every element in the source Iterable
+// using a mapper function that may fail. Unlike TryMap it never stops early: it also
+// returns a slice of errors aligned with the result, holding the error for each
+// element whose mapping failed and nil for each one that succeeded. Failed elements
+// keep whatever value the mapper returned alongside the error.
+func MapErr[T comparable, U comparable](
+	iter *Iterable[T],
+	mapper func(item T) (U, error),
+) (*Iterable[U], []error) {
+	mapped := make([]U, 0, iter.Len())
+	errs := make([]error, 0, iter.Len())
+	for _, item := range iter.Collect() {
+		result, err := mapper(item)
+		mapped = append(mapped, result)
+		errs = append(errs, err)
+	}
+
+	return Wrap(mapped), errs
+}
+
+// Reduce folds the collection from left to right into a single accumulated value.
+// The reducer receives the running accumulator and each element in order, starting
+// from initial. For an empty collection initial is returned unchanged. The underlying
+// collection is never modified.
+func Reduce[T comparable, A comparable](
+	iter *Iterable[T],
+	initial A,
+	reducer func(acc A, item T) A,
+) A {
+	acc := initial
+	for _, item := range iter.Collect() {
+		acc = reducer(acc, item)
+	}
+
+	return acc
+}
+
+// ReduceWhile folds the collection from left to right like Reduce, but fn also reports
+// whether to continue. The accumulator returned by the call that reports false is the
+// final result, and no further elements are visited. For an empty collection initial
+// is returned unchanged.
+func ReduceWhile[T comparable, A comparable](
+	iter *Iterable[T],
+	initial A,
+	fn func(acc A, item T) (A, bool),
+) A {
+	acc := initial
+	for _, item := range iter.Collect() {
+		var next bool
+		if acc, next = fn(acc, item); !next {
+			break
+		}
+	}
+
+	return acc
+}
+
+// Scan creates a new Iterable of running accumulations, like a prefix sum. It applies
+// fn to each element in order, starting from initial, and emits every intermediate
+// accumulator value. The seed itself is not emitted, so the result has the same
+// length as the source and its last element equals the result of Reduce.
+func Scan[T comparable, A comparable](
+	iter *Iterable[T],
+	initial A,
+	fn func(acc A, item T) A,
+) *Iterable[A] {
+	scanned := make([]A, 0, iter.Len())
+
+	acc := initial
+	for _, item := range iter.Collect() {
+		acc = fn(acc, item)
+		scanned = append(scanned, acc)
+	}
+
+	return Wrap(scanned)
+}
+
+// Sort sorts the collection of an Iterable with ordered elements in ascending order.
+// The collection is sorted in place and the same Iterable is returned to enable
+// method chaining.
+func Sort[T cmp.Ordered](iter *Iterable[T]) *Iterable[T] {
+	slices.Sort(iter.collection)
+
+	return iter
+}
+
+// CollectSorted returns a new slice holding the elements of an Iterable with ordered
+// elements in ascending order. The Iterable's own collection is not modified.
+func CollectSorted[T cmp.Ordered](iter *Iterable[T]) []T {
+	sorted := slices.Clone(iter.collection)
+	if sorted == nil {
+		sorted = []T{}
+	}
+
+	slices.Sort(sorted)
+
+	return sorted
+}
+
+// SortBy sorts the collection in ascending order of the key returned by keyFn.
+// The sort is stable, so elements with equal keys keep their original relative order.
+// The collection is sorted in place and the same Iterable is returned to enable
+// method chaining.
+func SortBy[T comparable, K cmp.Ordered](iter *Iterable[T], keyFn func(item T) K) *Iterable[T] {
+	return iter.SortStableFunc(func(a, b T) int {
+		return cmp.Compare(keyFn(a), keyFn(b))
+	})
+}
+
+// IsSorted reports whether an Iterable with ordered elements is sorted in ascending
+// order. Empty and single-element collections are always sorted.
+func IsSorted[T cmp.Ordered](iter *Iterable[T]) bool {
+	return slices.IsSorted(iter.collection)
+}
+
+// BinarySearch searches for target in an Iterable with ordered elements sorted in
+// ascending order, as slices.BinarySearch does. It returns the index at which target
+// was found, or the index at which it would be inserted, and whether it was found.
+func BinarySearch[T cmp.Ordered](iter *Iterable[T], target T) (int, bool) {
+	return slices.BinarySearch(iter.collection, target)
+}
+
+// Min returns the smallest element of an Iterable with ordered elements and true.
+// For an empty collection it returns the zero value of T and false.
+func Min[T cmp.Ordered](iter *Iterable[T]) (T, bool) {
+	if iter.Len() == 0 {
+		var zero T
+
+		return zero, false
+	}
+
+	return slices.Min(iter.collection), true
+}
+
+// Max returns the largest element of an Iterable with ordered elements and true.
+// For an empty collection it returns the zero value of T and false.
+func Max[T cmp.Ordered](iter *Iterable[T]) (T, bool) {
+	if iter.Len() == 0 {
+		var zero T
+
+		return zero, false
+	}
+
+	return slices.Max(iter.collection), true
+}
+
+// MinMax returns both the smallest and the largest element of an Iterable with
+// ordered elements, found in a single pass, and true. For an empty collection it
+// returns two zero values of T and false.
+func MinMax[T cmp.Ordered](iter *Iterable[T]) (T, T, bool) {
+	if iter.Len() == 0 {
+		var zero T
+
+		return zero, zero, false
+	}
+
+	smallest, largest := iter.collection[0], iter.collection[0]
+	for _, item := range iter.collection[1:] {
+		smallest = min(smallest, item)
+		largest = max(largest, item)
+	}
+
+	return smallest, largest, true
+}
+
+// TopN creates a new Iterable holding the n largest elements of an Iterable with
+// ordered elements, in descending order. It keeps a bounded heap of n elements, so
+// it runs in O(Len·log n) time. A non-positive n yields an empty result, and an n
+// larger than Len yields every element. The collection is not modified.
+func TopN[T cmp.Ordered](iter *Iterable[T], n int) *Iterable[T] {
+	top := selectN(iter, n, cmp.Less[T])
+	slices.SortFunc(top, func(a, b T) int { return cmp.Compare(b, a) })
+
+	return Wrap(top)
+}
+
+// BottomN creates a new Iterable holding the n smallest elements of an Iterable with
+// ordered elements, in ascending order. It is the counterpart of TopN.
+func BottomN[T cmp.Ordered](iter *Iterable[T], n int) *Iterable[T] {
+	bottom := selectN(iter, n, func(a, b T) bool { return cmp.Less(b, a) })
+	slices.Sort(bottom)
+
+	return Wrap(bottom)
+}
+
+// selectN returns, in no particular order, the n elements of the collection that come
+// last according to less, using a heap whose root is the first of the kept elements.
+func selectN[T comparable](iter *Iterable[T], n int, less func(a, b T) bool) []T {
+	kept := &boundedHeap[T]{
+		items: make([]T, 0, clamp(n, 0, iter.Len())),
+		less:  less,
+	}
+
+	for _, item := range iter.Collect() {
+		switch {
+		case kept.Len() < n:
+			heap.Push(kept, item)
+		case n > 0 && less(kept.items[0], item):
+			kept.items[0] = item
+			heap.Fix(kept, 0)
+		}
+	}
+
+	return kept.items
+}
+
+// boundedHeap adapts a slice and an ordering to heap.Interface for selectN.
+type boundedHeap[T comparable] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+func (h *boundedHeap[T]) Len() int {
+	return len(h.items)
+}
+
+func (h *boundedHeap[T]) Less(a, b int) bool {
+	return h.less(h.items[a], h.items[b])
+}
+
+func (h *boundedHeap[T]) Swap(a, b int) {
+	h.items[a], h.items[b] = h.items[b], h.items[a]
+}
+
+func (h *boundedHeap[T]) Push(x any) {
+	h.items = append(h.items, x.(T)) //nolint:forcetypeassert // only selectN pushes, always with T
+}
+
+func (h *boundedHeap[T]) Pop() any {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+
+	return last
+}
+
+// Numeric is a constraint that permits any integer or floating-point type.
+type Numeric interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Sum returns the sum of all elements in a numeric Iterable.
+// For an empty collection it returns the zero value of T.
+func Sum[T Numeric](iter *Iterable[T]) T {
+	var sum T
+	for _, item := range iter.Collect() {
+		sum += item
+	}
+
+	return sum
+}
+
+// Average returns the arithmetic mean of all elements in a numeric Iterable as a
+// float64 and true. For an empty collection it returns 0 and false. The elements are
+// added up as float64 values, so the total of narrow integer types cannot overflow.
+func Average[T Numeric](iter *Iterable[T]) (float64, bool) {
+	if iter.Len() == 0 {
+		return 0, false
+	}
+
+	var total float64
+	for _, item := range iter.Collect() {
+		total += float64(item)
+	}
+
+	return total / float64(iter.Len()), true
+}
+
+// MovingAverage creates a new Iterable holding the mean of each sliding window of
+// window consecutive elements, as produced by Window. If window is not positive or
+// exceeds the number of elements, the result is empty.
+func MovingAverage[T Numeric](iter *Iterable[T], window int) *Iterable[float64] {
+	if window <= 0 {
+		return Wrap([]float64{})
+	}
+
+	windows := Window(iter, window)
+
+	averages := make([]float64, 0, len(windows))
+	for _, values := range windows {
+		average, _ := Average(Wrap(values))
+		averages = append(averages, average)
+	}
+
+	return Wrap(averages)
+}
+
+// Histogram counts the elements of a numeric Iterable falling into each of bins
+// equal-width buckets spanning [lower, upper]. A value on the boundary between two
+// buckets is counted in the higher one, except upper itself, which belongs to the
+// last bucket. Values outside the range are clamped into the first or last bucket.
+// Histogram panics if bins is not positive or if upper is not greater than lower.
+func Histogram[T Numeric](iter *Iterable[T], bins int, lower, upper T) []int {
+	if bins <= 0 {
+		panic("iterable: histogram bins must be positive")
+	}
+
+	if upper <= lower {
+		panic("iterable: histogram upper bound must be greater than lower bound")
+	}
+
+	span := float64(upper) - float64(lower)
+
+	counts := make([]int, bins)
+	for _, item := range iter.Collect() {
+		position := (float64(item) - float64(lower)) * float64(bins) / span
+		counts[clamp(int(max(-1, min(position, float64(bins)))), 0, bins-1)]++
+	}
+
+	return counts
+}
+
+// StatsResult summarizes a numeric collection.
+type StatsResult[T Numeric] struct {
+	Count int
+	Sum   T
+	Min   T
+	Max   T
+	Mean  float64
+}
+
+// Stats computes the count, sum, minimum, maximum and mean of a numeric Iterable in a
+// single pass and returns them with true. For an empty collection it returns a zero
+// StatsResult and false. The mean is computed from a separate float64 total, so it
+// stays accurate even when Sum overflows a narrow integer type.
+func Stats[T Numeric](iter *Iterable[T]) (StatsResult[T], bool) {
+	if iter.Len() == 0 {
+		return StatsResult[T]{}, false
+	}
+
+	var total float64
+
+	result := StatsResult[T]{Min: iter.collection[0], Max: iter.collection[0]}
+	for _, item := range iter.collection {
+		result.Count++
+		result.Sum += item
+		result.Min = min(result.Min, item)
+		result.Max = max(result.Max, item)
+		total += float64(item)
+	}
+
+	result.Mean = total / float64(result.Count)
+
+	return result, true
+}
+
+// clamp restricts value to the inclusive range [low, high].
+func clamp(value, low, high int) int {
+	return max(low, min(value, high))
+}
+
+// Chunk splits the collection into consecutive batches of at most size elements.
+// Every batch has exactly size elements except possibly the last one. Each batch
+// is a copy, so modifying it does not affect the Iterable.
+// Chunk panics if size is not positive.
+func Chunk[T comparable](iter *Iterable[T], size int) [][]T {
+	if size <= 0 {
+		panic("iterable: chunk size must be positive")
+	}
+
+	chunks := make([][]T, 0, (iter.Len()+size-1)/size)
+	for start := 0; start < iter.Len(); start += size {
+		end := min(start+size, iter.Len())
+		chunks = append(chunks, slices.Clone(iter.collection[start:end]))
+	}
+
+	return chunks
+}
+
+// Window returns every run of size consecutive elements, advancing by one element
+// at a time, so a collection of n elements yields n-size+1 windows. If size exceeds
+// the number of elements, the result is empty. Each window is a copy, so modifying
+// it does not affect the Iterable.
+// Window panics if size is not positive.
+func Window[T comparable](iter *Iterable[T], size int) [][]T {
+	if size <= 0 {
+		panic("iterable: window size must be positive")
+	}
+
+	windows := make([][]T, 0, max(iter.Len()-size+1, 0))
+	for start := 0; start+size <= iter.Len(); start++ {
+		windows = append(windows, slices.Clone(iter.collection[start:start+size]))
+	}
+
+	return windows
+}
+
+// ChunkWhile splits the collection into runs of consecutive elements, starting a new
+// chunk whenever sameGroup(prev, curr) returns false for two adjacent elements.
+// Each chunk is a copy, so modifying it does not affect the Iterable.
+func ChunkWhile[T comparable](iter *Iterable[T], sameGroup func(prev, curr T) bool) [][]T {
+	chunks := make([][]T, 0)
+
+	start := 0
+	for idx := 1; idx <= iter.Len(); idx++ {
+		if idx == iter.Len() || !sameGroup(iter.collection[idx-1], iter.collection[idx]) {
+			chunks = append(chunks, slices.Clone(iter.collection[start:idx]))
+			start = idx
+		}
+	}
+
+	return chunks
+}
+
+// ChunkBy splits the collection into runs of consecutive elements, starting a new
+// chunk whenever the key returned from keyFn differs from that of the previous
+// element. It is GroupConsecutiveBy without the keys.
+func ChunkBy[T comparable, K comparable](iter *Iterable[T], keyFn func(item T) K) [][]T {
+	groups := GroupConsecutiveBy(iter, keyFn)
+
+	chunks := make([][]T, 0, len(groups))
+	for _, group := range groups {
+		chunks = append(chunks, group.Second)
+	}
+
+	return chunks
+}
+
+// Combinations returns every way of choosing k elements from the collection without
+// repetition, in lexicographic order of their indices, with each combination keeping
+// the elements in collection order. Choosing 0 elements yields a single empty
+// combination, while a negative k or one greater than Len yields none.
+func Combinations[T comparable](iter *Iterable[T], k int) [][]T {
+	n := iter.Len()
+	if k < 0 || k > n {
+		return [][]T{}
+	}
+
+	indices := make([]int, k)
+	for idx := range indices {
+		indices[idx] = idx
+	}
+
+	var combinations [][]T
+
+	for {
+		combination := make([]T, k)
+		for idx, source := range indices {
+			combination[idx] = iter.collection[source]
+		}
+
+		combinations = append(combinations, combination)
+
+		// Advance the rightmost index that can still move, then reset the ones after it.
+		pos := k - 1
+		for pos >= 0 && indices[pos] == n-k+pos {
+			pos--
+		}
+
+		if pos < 0 {
+			return combinations
+		}
+
+		indices[pos]++
+		for idx := pos + 1; idx < k; idx++ {
+			indices[idx] = indices[idx-1] + 1
+		}
+	}
+}
+
+// Permutations returns every ordering of the elements in the collection, in
+// lexicographic order of their indices. An empty collection yields a single empty
+// permutation. The number of results grows factorially with Len (10 elements already
+// produce 3,628,800 permutations), so it is only suitable for small collections.
+func Permutations[T comparable](iter *Iterable[T]) [][]T {
+	var (
+		permutations [][]T
+		current      = make([]T, 0, iter.Len())
+		used         = make([]bool, iter.Len())
+		permute      func()
+	)
+
+	permute = func() {
+		if len(current) == iter.Len() {
+			permutations = append(permutations, slices.Clone(current))
+
+			return
+		}
+
+		for idx, item := range iter.collection {
+			if used[idx] {
+				continue
+			}
+
+			used[idx] = true
+			current = append(current, item)
+			permute()
+			current = current[:len(current)-1]
+			used[idx] = false
+		}
+	}
+
+	permute()
+
+	return permutations
+}
+
+// GroupBy buckets the elements of the collection by the key returned from keyFn.
+// Elements keep their original relative order within each bucket. The collection
+// is not modified, and an empty collection produces an empty map.
+func GroupBy[T comparable, K comparable](iter *Iterable[T], keyFn func(item T) K) map[K][]T {
+	groups := make(map[K][]T)
+	for _, item := range iter.Collect() {
+		key := keyFn(item)
+		groups[key] = append(groups[key], item)
+	}
+
+	return groups
+}
+
+// GroupConsecutiveBy splits the collection into runs of adjacent elements that share
+// the key returned from keyFn, returning a (key, group) pair for each run in order.
+// Unlike GroupBy, a key that reappears after a different key starts a new group.
+func GroupConsecutiveBy[T comparable, K comparable](
+	iter *Iterable[T],
+	keyFn func(item T) K,
+) []Pair[K, []T] {
+	groups := make([]Pair[K, []T], 0)
+	for _, item := range iter.Collect() {
+		key := keyFn(item)
+		if last := len(groups) - 1; last >= 0 && groups[last].First == key {
+			groups[last].Second = append(groups[last].Second, item)
+
+			continue
+		}
+
+		groups = append(groups, Pair[K, []T]{First: key, Second: []T{item}})
+	}
+
+	return groups
+}
+
+// Flatten creates a new Iterable by concatenating the nested slices in order.
+// Nil and empty inner slices are skipped. It is the inverse of Chunk.
+func Flatten[T comparable](nested [][]T) *Iterable[T] {
+	flattened := make([]T, 0, len(nested))
+	for _, inner := range nested {
+		flattened = append(flattened, inner...)
+	}
+
+	return Wrap(flattened)
+}
+
+// Pair holds two related values. A Pair is comparable, and can therefore be stored
+// in an Iterable, whenever both of its type arguments are comparable.
+type Pair[T, U any] struct {
+	First  T
+	Second U
+}
+
+// Zip creates a new Iterable of pairs by combining the elements of a and b at the
+// same positions. The result stops at the length of the shorter of the two.
+func Zip[T comparable, U comparable](a *Iterable[T], b *Iterable[U]) *Iterable[Pair[T, U]] {
+	length := min(a.Len(), b.Len())
+
+	zipped := make([]Pair[T, U], 0, length)
+	for idx := range length {
+		zipped = append(zipped, Pair[T, U]{First: a.collection[idx], Second: b.collection[idx]})
+	}
+
+	return Wrap(zipped)
+}
+
+// Product creates a new Iterable holding the Cartesian product of a and b: a pair for
+// every combination of an element of a with an element of b, in row-major order.
+// If either input is empty, the result is empty.
+func Product[T comparable, U comparable](a *Iterable[T], b *Iterable[U]) *Iterable[Pair[T, U]] {
+	product := make([]Pair[T, U], 0, a.Len()*b.Len())
+	for _, first := range a.Collect() {
+		for _, second := range b.Collect() {
+			product = append(product, Pair[T, U]{First: first, Second: second})
+		}
+	}
+
+	return Wrap(product)
+}
+
+// Interleave creates a new Iterable that alternates elements from a and b, starting
+// with a. Once the shorter collection is exhausted, the remaining elements of the
+// longer one are appended in order.
+func Interleave[T comparable](a, b *Iterable[T]) *Iterable[T] {
+	shorter := min(a.Len(), b.Len())
+
+	interleaved := make([]T, 0, a.Len()+b.Len())
+	for idx := range shorter {
+		interleaved = append(interleaved, a.collection[idx], b.collection[idx])
+	}
+
+	interleaved = append(interleaved, a.collection[shorter:]...)
+	interleaved = append(interleaved, b.collection[shorter:]...)
+
+	return Wrap(interleaved)
+}
+
+// RunLengthEncode creates a new Iterable that collapses each run of adjacent equal
+// elements into a pair of the element and the length of the run. It is a function
+// rather than a method because a method of Iterable[T] cannot return an
+// Iterable[Pair[T, int]].
+func RunLengthEncode[T comparable](iter *Iterable[T]) *Iterable[Pair[T, int]] {
+	runs := make([]Pair[T, int], 0)
+	for _, item := range iter.Collect() {
+		if last := len(runs) - 1; last >= 0 && runs[last].First == item {
+			runs[last].Second++
+		} else {
+			runs = append(runs, Pair[T, int]{First: item, Second: 1})
+		}
+	}
+
+	return Wrap(runs)
+}
+
+// IndexedItem holds an element together with its position in a collection.
+type IndexedItem[T any] struct {
+	Index int
+	Value T
+}
+
+// Enumerate creates a new Iterable that pairs each element with its 0-based index in
+// the current collection.
+func Enumerate[T comparable](iter *Iterable[T]) *Iterable[IndexedItem[T]] {
+	enumerated := make([]IndexedItem[T], 0, iter.Len())
+	for idx, item := range iter.Collect() {
+		enumerated = append(enumerated, IndexedItem[T]{Index: idx, Value: item})
+	}
+
+	return Wrap(enumerated)
+}
+
+// Unzip splits an Iterable of pairs into two new Iterables holding the first and
+// second values respectively. Both results have the same length as the input, which
+// makes Unzip the inverse of Zip for inputs of equal length.
+func Unzip[T comparable, U comparable](iter *Iterable[Pair[T, U]]) (*Iterable[T], *Iterable[U]) {
+	firsts := make([]T, 0, iter.Len())
+	seconds := make([]U, 0, iter.Len())
+
+	for _, pair := range iter.Collect() {
+		firsts = append(firsts, pair.First)
+		seconds = append(seconds, pair.Second)
+	}
+
+	return Wrap(firsts), Wrap(seconds)
+}
+
+// DistinctBy removes elements whose key, as returned by keyFn, has already been seen,
+// keeping only the first element for each distinct key. The order of remaining
+// elements is preserved. Like Unique, it updates the given Iterable and returns it
+// to enable method chaining.
+func DistinctBy[T comparable, K comparable](iter *Iterable[T], keyFn func(item T) K) *Iterable[T] {
+	seen := make(map[K]bool)
+	result := make([]T, 0, iter.Len())
+
+	for _, item := range iter.collection {
+		key := keyFn(item)
+		if !seen[key] {
+			seen[key] = true
+
+			result = append(result, item)
+		}
+	}
+
+	iter.collection = result
+
+	return iter
+}
+
+// CountBy returns how many elements fall under each key returned by keyFn.
+// An empty collection produces an empty map.
+func CountBy[T comparable, K comparable](iter *Iterable[T], keyFn func(item T) K) map[K]int {
+	counts := make(map[K]int)
+	for _, item := range iter.Collect() {
+		counts[keyFn(item)]++
+	}
+
+	return counts
+}
+
+// Join concatenates the elements of an Iterable of strings, placing sep between
+// adjacent elements, like strings.Join. An empty collection produces "".
+func Join[T ~string](iter *Iterable[T], sep string) string {
+	return JoinBy(iter, sep, func(item T) string { return string(item) })
+}
+
+// JoinBy converts each element of the collection to a string with toString and
+// concatenates the results, placing sep between adjacent elements. An empty
+// collection produces "".
+func JoinBy[T comparable](iter *Iterable[T], sep string, toString func(item T) string) string {
+	var builder strings.Builder
+	for idx, item := range iter.Collect() {
+		if idx > 0 {
+			builder.WriteString(sep)
+		}
+
+		builder.WriteString(toString(item))
+	}
+
+	return builder.String()
+}
+
+// ToMap builds a map by projecting each element into a key and value pair with fn.
+// When several elements produce the same key, the value of the last one wins.
+func ToMap[T comparable, K comparable, V any](iter *Iterable[T], fn func(item T) (K, V)) map[K]V {
+	result := make(map[K]V, iter.Len())
+	for _, item := range iter.Collect() {
+		key, value := fn(item)
+		result[key] = value
+	}
+
+	return result
+}
+
+// ReduceMap folds the collection into a map by calling fn with the accumulated map
+// and each element in order, letting fn insert or update entries as it sees fit.
+// The map starts empty, and an empty collection produces an empty map.
+func ReduceMap[T comparable, K comparable, V any](
+	iter *Iterable[T],
+	fn func(result map[K]V, item T),
+) map[K]V {
+	result := make(map[K]V)
+	for _, item := range iter.Collect() {
+		fn(result, item)
 	}
 
-	return New(mapped)
+	return result
 }
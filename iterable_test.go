@@ -1,6 +1,14 @@
 package iterable
 
 import (
+	"cmp"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -46,6 +54,27 @@ func (s *IterableSuite) TestNew() {
 	}
 }
 
+func (s *IterableSuite) TestOwnership() {
+	s.Run("new copies input", func() {
+		input := []int{3, 1, 2}
+		New(input).
+			Mutate(func(i *int) { *i *= 10 }).
+			Reverse().
+			Unique()
+		s.Equal([]int{3, 1, 2}, input)
+	})
+
+	s.Run("new copies nil input", func() {
+		s.Nil(New[int](nil).Collect())
+	})
+
+	s.Run("wrap shares input", func() {
+		input := []int{3, 1, 2}
+		Wrap(input).Mutate(func(i *int) { *i *= 10 })
+		s.Equal([]int{30, 10, 20}, input)
+	})
+}
+
 func (s *IterableSuite) TestFilter() {
 	s.Run("integer filtering", func() {
 		tests := []struct {
@@ -95,6 +124,122 @@ func (s *IterableSuite) TestFilter() {
 			Collect()
 		s.Equal([]string{"hello", "world", "test"}, result)
 	})
+
+	s.Run("input slice is not modified", func() {
+		input := []int{1, 2, 3, 4, 5, 6}
+		result := New(input).Filter(func(i int) bool { return i%2 == 0 }).Collect()
+		s.Equal([]int{2, 4, 6}, result)
+		s.Equal([]int{1, 2, 3, 4, 5, 6}, input)
+	})
+}
+
+func (s *IterableSuite) TestFilterWithIndex() {
+	tests := []struct {
+		name      string
+		input     []string
+		predicate func(index int, item string) bool
+		expected  []string
+	}{
+		{
+			name:      "empty slice",
+			input:     []string{},
+			predicate: func(int, string) bool { return true },
+			expected:  []string{},
+		},
+		{
+			name:      "keep even indices",
+			input:     []string{"a", "b", "c", "d", "e"},
+			predicate: func(idx int, _ string) bool { return idx%2 == 0 },
+			expected:  []string{"a", "c", "e"},
+		},
+		{
+			name:      "drop first",
+			input:     []string{"header", "x", "y"},
+			predicate: func(idx int, _ string) bool { return idx != 0 },
+			expected:  []string{"x", "y"},
+		},
+		{
+			name:      "drop specific index",
+			input:     []string{"a", "b", "c", "d"},
+			predicate: func(idx int, _ string) bool { return idx != 2 },
+			expected:  []string{"a", "b", "d"},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			result := New(tt.input).FilterWithIndex(tt.predicate).Collect()
+			s.Equal(tt.expected, result)
+		})
+	}
+
+	s.Run("indices are original positions", func() {
+		var seen []int
+		New([]int{5, 5, 5, 5}).FilterWithIndex(func(idx int, _ int) bool {
+			seen = append(seen, idx)
+
+			return idx%2 == 1
+		})
+		s.Equal([]int{0, 1, 2, 3}, seen)
+	})
+}
+
+func (s *IterableSuite) TestReject() {
+	s.Run("integer rejection", func() {
+		tests := []struct {
+			name      string
+			input     []int
+			predicate func(item int) bool
+			expected  []int
+		}{
+			{
+				name:      "empty slice",
+				input:     []int{},
+				predicate: func(int) bool { return true },
+				expected:  []int{},
+			},
+			{
+				name:      "reject all",
+				input:     []int{1, 2, 3},
+				predicate: func(int) bool { return true },
+				expected:  []int{},
+			},
+			{
+				name:      "reject none",
+				input:     []int{1, 2, 3},
+				predicate: func(int) bool { return false },
+				expected:  []int{1, 2, 3},
+			},
+			{
+				name:      "reject even numbers",
+				input:     []int{1, 2, 3, 4, 5, 6},
+				predicate: func(item int) bool { return item%2 == 0 },
+				expected:  []int{1, 3, 5},
+			},
+		}
+
+		for _, tt := range tests {
+			s.Run(tt.name, func() {
+				result := New(tt.input).Reject(tt.predicate).Collect()
+				s.Equal(tt.expected, result)
+			})
+		}
+	})
+
+	s.Run("string rejection", func() {
+		input := []string{"hello", "world", "test", "go"}
+		result := New(input).
+			Reject(func(s string) bool { return len(s) > 3 }).
+			Collect()
+		s.Equal([]string{"go"}, result)
+	})
+
+	s.Run("input slice is not modified", func() {
+		input := []int{1, 2, 3, 4, 5, 6}
+		result := New(input).Reject(func(i int) bool { return i%2 == 0 }).Collect()
+		s.Equal([]int{1, 3, 5}, result)
+		s.Equal([]int{1, 2, 3, 4, 5, 6}, input)
+	})
 }
 
 func (s *IterableSuite) TestMutate() {
@@ -142,6 +287,124 @@ func (s *IterableSuite) TestMutate() {
 	})
 }
 
+func (s *IterableSuite) TestMutateCopy() {
+	double := func(i *int) { *i *= 2 }
+
+	s.Run("new input is unchanged", func() {
+		input := []int{1, 2, 3}
+		result := New(input).MutateCopy(double).Collect()
+		s.Equal([]int{2, 4, 6}, result)
+		s.Equal([]int{1, 2, 3}, input)
+	})
+
+	s.Run("wrapped input is unchanged", func() {
+		input := []int{1, 2, 3}
+		result := Wrap(input).MutateCopy(double).Collect()
+		s.Equal([]int{2, 4, 6}, result)
+		s.Equal([]int{1, 2, 3}, input)
+	})
+
+	s.Run("empty slice", func() {
+		s.Equal([]int{}, New([]int{}).MutateCopy(double).Collect())
+	})
+}
+
+func (s *IterableSuite) TestForEach() {
+	s.Run("visits elements in order", func() {
+		tests := []struct {
+			name     string
+			input    []int
+			expected []int
+		}{
+			{
+				name:     "empty slice",
+				input:    []int{},
+				expected: nil,
+			},
+			{
+				name:     "multiple elements",
+				input:    []int{3, 1, 2},
+				expected: []int{3, 1, 2},
+			},
+		}
+
+		for _, tt := range tests {
+			s.Run(tt.name, func() {
+				var visited []int
+
+				New(tt.input).ForEach(func(item int) { visited = append(visited, item) })
+				s.Equal(tt.expected, visited)
+			})
+		}
+	})
+
+	s.Run("chaining with other operations", func() {
+		sum := 0
+		result := New([]int{1, 2, 3, 4}).
+			ForEach(func(item int) { sum += item }).
+			Filter(func(i int) bool { return i > 2 }).
+			Collect()
+		s.Equal(10, sum)
+		s.Equal([]int{3, 4}, result)
+	})
+}
+
+func (s *IterableSuite) TestForEachWhile() {
+	tests := []struct {
+		name          string
+		input         []int
+		limit         int
+		expectedSeen  []int
+		expectedCalls int
+	}{
+		{
+			name:          "empty slice",
+			input:         []int{},
+			limit:         10,
+			expectedSeen:  nil,
+			expectedCalls: 0,
+		},
+		{
+			name:          "stops early",
+			input:         []int{1, 2, 3, 4, 5},
+			limit:         3,
+			expectedSeen:  []int{1, 2, 3},
+			expectedCalls: 3,
+		},
+		{
+			name:          "stops at first element",
+			input:         []int{1, 2, 3},
+			limit:         1,
+			expectedSeen:  []int{1},
+			expectedCalls: 1,
+		},
+		{
+			name:          "never stops",
+			input:         []int{1, 2, 3},
+			limit:         10,
+			expectedSeen:  []int{1, 2, 3},
+			expectedCalls: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var seen []int
+			calls := 0
+			result := New(tt.input).ForEachWhile(func(item int) bool {
+				calls++
+				seen = append(seen, item)
+
+				return len(seen) < tt.limit
+			}).Collect()
+
+			s.Equal(tt.expectedSeen, seen)
+			s.Equal(tt.expectedCalls, calls)
+			s.Equal(tt.input, result)
+		})
+	}
+}
+
 func (s *IterableSuite) TestMap() {
 	s.Run("basic type mapping", func() {
 		tests := []struct {
@@ -183,6 +446,40 @@ func (s *IterableSuite) TestMap() {
 	})
 }
 
+func (s *IterableSuite) TestMapWithIndex() {
+	number := func(idx int, item string) string {
+		return fmt.Sprintf("%d. %s", idx+1, item)
+	}
+
+	tests := []struct {
+		name     string
+		input    []string
+		expected []string
+	}{
+		{
+			name:     "empty slice",
+			input:    []string{},
+			expected: []string{},
+		},
+		{
+			name:     "numbered rows",
+			input:    []string{"a", "b", "c"},
+			expected: []string{"1. a", "2. b", "3. c"},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, MapWithIndex(New(tt.input), number).Collect())
+		})
+	}
+
+	s.Run("indices follow current order", func() {
+		result := MapWithIndex(New([]string{"a", "b", "c"}).Reverse(), number).Collect()
+		s.Equal([]string{"1. c", "2. b", "3. a"}, result)
+	})
+}
+
 func (s *IterableSuite) TestChaining() {
 	s.Run("multiple operations", func() {
 		input := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
@@ -272,6 +569,74 @@ func (s *IterableSuite) TestEdgeCases() {
 		s.Panics(func() {
 			New([]int{1, 2, 3}).Mutate(nil)
 		}, "Mutate with nil mutator should panic")
+
+		s.Panics(func() {
+			New([]int{1, 2, 3}).ForEach(nil)
+		}, "ForEach with nil fn should panic")
+
+		s.Panics(func() {
+			New([]int{1, 2, 3}).CountFunc(nil)
+		}, "CountFunc with nil predicate should panic")
+
+		s.Panics(func() {
+			New([]int{1, 2, 3}).Any(nil)
+		}, "Any with nil predicate should panic")
+
+		s.Panics(func() {
+			New([]int{1, 2, 3}).All(nil)
+		}, "All with nil predicate should panic")
+
+		s.Panics(func() {
+			New([]int{1, 2, 3}).None(nil)
+		}, "None with nil predicate should panic")
+
+		s.Panics(func() {
+			New([]int{1, 2, 3}).Find(nil)
+		}, "Find with nil predicate should panic")
+
+		s.Panics(func() {
+			New([]int{1, 2, 3}).FindIndex(nil)
+		}, "FindIndex with nil predicate should panic")
+
+		s.Panics(func() {
+			New([]int{1, 2, 3}).TakeWhile(nil)
+		}, "TakeWhile with nil predicate should panic")
+
+		s.Panics(func() {
+			New([]int{1, 2, 3}).DropWhile(nil)
+		}, "DropWhile with nil predicate should panic")
+
+		s.Panics(func() {
+			New([]int{1, 2, 3}).Partition(nil)
+		}, "Partition with nil predicate should panic")
+
+		s.Panics(func() {
+			New([]int{1, 2, 3}).Tap(nil)
+		}, "Tap with nil fn should panic")
+
+		s.Panics(func() {
+			_ = New([]int{1, 2, 3}).TryForEach(nil)
+		}, "TryForEach with nil fn should panic")
+
+		s.Panics(func() {
+			New([]int{1, 2, 3}).Reject(nil)
+		}, "Reject with nil predicate should panic")
+
+		s.Panics(func() {
+			New([]int{1, 2, 3}).MutateCopy(nil)
+		}, "MutateCopy with nil mutator should panic")
+
+		s.Panics(func() {
+			New([]int{1, 2, 3}).FilterWithIndex(nil)
+		}, "FilterWithIndex with nil predicate should panic")
+
+		s.Panics(func() {
+			New([]int{1, 2, 3}).ReplaceFunc(nil, 0)
+		}, "ReplaceFunc with nil predicate should panic")
+
+		s.Panics(func() {
+			New([]int{1, 2, 3}).ForEachWhile(nil)
+		}, "ForEachWhile with nil fn should panic")
 	})
 
 	s.Run("zero values", func() {
@@ -299,3 +664,4030 @@ func (s *IterableSuite) TestEdgeCases() {
 		s.Equal(1996, result[len(result)-1])
 	})
 }
+
+func (s *IterableSuite) TestReduce() {
+	s.Run("sum integers", func() {
+		tests := []struct {
+			name     string
+			input    []int
+			initial  int
+			expected int
+		}{
+			{
+				name:     "empty slice",
+				input:    []int{},
+				initial:  0,
+				expected: 0,
+			},
+			{
+				name:     "empty slice keeps initial",
+				input:    []int{},
+				initial:  42,
+				expected: 42,
+			},
+			{
+				name:     "multiple elements",
+				input:    []int{1, 2, 3, 4, 5},
+				initial:  0,
+				expected: 15,
+			},
+			{
+				name:     "non-zero initial",
+				input:    []int{1, 2, 3},
+				initial:  10,
+				expected: 16,
+			},
+		}
+
+		for _, tt := range tests {
+			s.Run(tt.name, func() {
+				result := Reduce(New(tt.input), tt.initial, func(acc, item int) int {
+					return acc + item
+				})
+				s.Equal(tt.expected, result)
+			})
+		}
+	})
+
+	s.Run("concatenate strings", func() {
+		input := []string{"go", "is", "fun"}
+		result := Reduce(New(input), "", func(acc string, item string) string {
+			return acc + item
+		})
+		s.Equal("goisfun", result)
+	})
+
+	s.Run("does not modify collection", func() {
+		input := []int{3, 1, 2}
+		iter := New(input)
+		Reduce(iter, 0, func(acc, item int) int { return acc + item })
+		s.Equal([]int{3, 1, 2}, iter.Collect())
+	})
+}
+
+func (s *IterableSuite) TestCount() {
+	s.Run("count", func() {
+		s.Equal(0, New([]int{}).Count())
+		s.Equal(3, New([]int{1, 2, 3}).Count())
+		s.Equal(2, New([]int{1, 2, 3}).Filter(func(i int) bool { return i > 1 }).Count())
+	})
+
+	s.Run("count func", func() {
+		tests := []struct {
+			name      string
+			input     []int
+			predicate func(item int) bool
+			expected  int
+		}{
+			{
+				name:      "empty slice",
+				input:     []int{},
+				predicate: func(int) bool { return true },
+				expected:  0,
+			},
+			{
+				name:      "zero matches",
+				input:     []int{1, 3, 5},
+				predicate: func(item int) bool { return item%2 == 0 },
+				expected:  0,
+			},
+			{
+				name:      "all matches",
+				input:     []int{2, 4, 6},
+				predicate: func(item int) bool { return item%2 == 0 },
+				expected:  3,
+			},
+			{
+				name:      "partial matches",
+				input:     []int{1, 2, 3, 4, 5, 6},
+				predicate: func(item int) bool { return item%2 == 0 },
+				expected:  3,
+			},
+		}
+
+		for _, tt := range tests {
+			s.Run(tt.name, func() {
+				s.Equal(tt.expected, New(tt.input).CountFunc(tt.predicate))
+			})
+		}
+	})
+
+	s.Run("count func does not modify collection", func() {
+		input := []int{1, 2, 3, 4}
+		iter := New(input)
+		iter.CountFunc(func(item int) bool { return item%2 == 0 })
+		s.Equal([]int{1, 2, 3, 4}, iter.Collect())
+		s.Equal([]int{1, 2, 3, 4}, input)
+	})
+}
+
+func (s *IterableSuite) TestPredicates() {
+	isEven := func(item int) bool { return item%2 == 0 }
+
+	tests := []struct {
+		name     string
+		input    []int
+		wantAny  bool
+		wantAll  bool
+		wantNone bool
+	}{
+		{
+			name:     "empty slice",
+			input:    []int{},
+			wantAny:  false,
+			wantAll:  true,
+			wantNone: true,
+		},
+		{
+			name:     "no matches",
+			input:    []int{1, 3, 5},
+			wantAny:  false,
+			wantAll:  false,
+			wantNone: true,
+		},
+		{
+			name:     "some matches",
+			input:    []int{1, 2, 3},
+			wantAny:  true,
+			wantAll:  false,
+			wantNone: false,
+		},
+		{
+			name:     "all matches",
+			input:    []int{2, 4, 6},
+			wantAny:  true,
+			wantAll:  true,
+			wantNone: false,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			iter := New(tt.input)
+			s.Equal(tt.wantAny, iter.Any(isEven))
+			s.Equal(tt.wantAll, iter.All(isEven))
+			s.Equal(tt.wantNone, iter.None(isEven))
+		})
+	}
+
+	s.Run("short circuit", func() {
+		input := []int{1, 2, 3, 4, 5}
+
+		calls := 0
+		s.True(New(input).Any(func(item int) bool {
+			calls++
+
+			return item == 2
+		}))
+		s.Equal(2, calls, "Any should stop at the first match")
+
+		calls = 0
+		s.False(New(input).All(func(item int) bool {
+			calls++
+
+			return item < 3
+		}))
+		s.Equal(3, calls, "All should stop at the first mismatch")
+
+		calls = 0
+		s.False(New(input).None(func(item int) bool {
+			calls++
+
+			return item == 1
+		}))
+		s.Equal(1, calls, "None should stop at the first match")
+	})
+}
+
+func (s *IterableSuite) TestSearch() {
+	tests := []struct {
+		name        string
+		input       []int
+		target      int
+		contains    bool
+		indexOf     int
+		lastIndexOf int
+	}{
+		{
+			name:        "empty slice",
+			input:       []int{},
+			target:      1,
+			contains:    false,
+			indexOf:     -1,
+			lastIndexOf: -1,
+		},
+		{
+			name:        "not found",
+			input:       []int{1, 2, 3},
+			target:      4,
+			contains:    false,
+			indexOf:     -1,
+			lastIndexOf: -1,
+		},
+		{
+			name:        "single occurrence",
+			input:       []int{1, 2, 3},
+			target:      2,
+			contains:    true,
+			indexOf:     1,
+			lastIndexOf: 1,
+		},
+		{
+			name:        "with duplicates",
+			input:       []int{5, 1, 5, 2, 5, 3},
+			target:      5,
+			contains:    true,
+			indexOf:     0,
+			lastIndexOf: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			iter := New(tt.input)
+			s.Equal(tt.contains, iter.Contains(tt.target))
+			s.Equal(tt.indexOf, iter.IndexOf(tt.target))
+			s.Equal(tt.lastIndexOf, iter.LastIndexOf(tt.target))
+		})
+	}
+
+	s.Run("after unique", func() {
+		iter := New([]string{"a", "b", "a", "c"}).Unique()
+		s.Equal(0, iter.IndexOf("a"))
+		s.Equal(0, iter.LastIndexOf("a"))
+		s.Equal(2, iter.IndexOf("c"))
+	})
+}
+
+func (s *IterableSuite) TestFind() {
+	tests := []struct {
+		name      string
+		input     []int
+		predicate func(item int) bool
+		expected  int
+		found     bool
+		index     int
+	}{
+		{
+			name:      "empty slice",
+			input:     []int{},
+			predicate: func(int) bool { return true },
+			expected:  0,
+			found:     false,
+			index:     -1,
+		},
+		{
+			name:      "not found",
+			input:     []int{1, 3, 5},
+			predicate: func(item int) bool { return item%2 == 0 },
+			expected:  0,
+			found:     false,
+			index:     -1,
+		},
+		{
+			name:      "first match returned",
+			input:     []int{1, 4, 3, 6},
+			predicate: func(item int) bool { return item%2 == 0 },
+			expected:  4,
+			found:     true,
+			index:     1,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			iter := New(tt.input)
+			result, found := iter.Find(tt.predicate)
+			s.Equal(tt.expected, result)
+			s.Equal(tt.found, found)
+			s.Equal(tt.index, iter.FindIndex(tt.predicate))
+		})
+	}
+
+	s.Run("stops at first match", func() {
+		calls := 0
+		result, found := New([]int{1, 2, 3, 4, 5}).Find(func(item int) bool {
+			calls++
+
+			return item > 1
+		})
+		s.True(found)
+		s.Equal(2, result)
+		s.Equal(2, calls)
+	})
+}
+
+func (s *IterableSuite) TestReverse() {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{
+			name:     "empty slice",
+			input:    []int{},
+			expected: []int{},
+		},
+		{
+			name:     "single element",
+			input:    []int{1},
+			expected: []int{1},
+		},
+		{
+			name:     "even length",
+			input:    []int{1, 2, 3, 4},
+			expected: []int{4, 3, 2, 1},
+		},
+		{
+			name:     "odd length",
+			input:    []int{1, 2, 3, 4, 5},
+			expected: []int{5, 4, 3, 2, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			result := New(tt.input).Reverse().Collect()
+			s.Equal(tt.expected, result)
+		})
+	}
+
+	s.Run("reverse twice restores order", func() {
+		result := New([]string{"a", "b", "c"}).Reverse().Reverse().Collect()
+		s.Equal([]string{"a", "b", "c"}, result)
+	})
+
+	s.Run("chaining with other operations", func() {
+		result := New([]int{1, 2, 3, 4, 5, 6}).
+			Filter(func(i int) bool { return i%2 == 0 }).
+			Reverse().
+			Collect()
+		s.Equal([]int{6, 4, 2}, result)
+	})
+}
+
+func (s *IterableSuite) TestSort() {
+	s.Run("ordered sorting", func() {
+		tests := []struct {
+			name     string
+			input    []int
+			expected []int
+		}{
+			{
+				name:     "empty slice",
+				input:    []int{},
+				expected: []int{},
+			},
+			{
+				name:     "already sorted",
+				input:    []int{1, 2, 3, 4},
+				expected: []int{1, 2, 3, 4},
+			},
+			{
+				name:     "reverse sorted",
+				input:    []int{4, 3, 2, 1},
+				expected: []int{1, 2, 3, 4},
+			},
+			{
+				name:     "with duplicates",
+				input:    []int{3, 1, 2, 3, 1},
+				expected: []int{1, 1, 2, 3, 3},
+			},
+		}
+
+		for _, tt := range tests {
+			s.Run(tt.name, func() {
+				result := Sort(New(tt.input)).Collect()
+				s.Equal(tt.expected, result)
+			})
+		}
+	})
+
+	s.Run("custom comparator", func() {
+		result := New([]string{"ccc", "a", "bb"}).
+			SortFunc(func(a, b string) int { return cmp.Compare(len(b), len(a)) }).
+			Collect()
+		s.Equal([]string{"ccc", "bb", "a"}, result)
+	})
+
+	s.Run("stable comparator", func() {
+		type record struct {
+			key   int
+			label string
+		}
+
+		input := []record{
+			{key: 2, label: "first two"},
+			{key: 1, label: "first one"},
+			{key: 2, label: "second two"},
+			{key: 1, label: "second one"},
+		}
+
+		result := New(input).
+			SortStableFunc(func(a, b record) int { return cmp.Compare(a.key, b.key) }).
+			Collect()
+		s.Equal([]record{
+			{key: 1, label: "first one"},
+			{key: 1, label: "second one"},
+			{key: 2, label: "first two"},
+			{key: 2, label: "second two"},
+		}, result)
+	})
+
+	s.Run("chaining with other operations", func() {
+		result := Sort(New([]int{5, 2, 8, 1, 4}).Filter(func(i int) bool { return i > 1 })).
+			Reverse().
+			Collect()
+		s.Equal([]int{8, 5, 4, 2}, result)
+	})
+}
+
+func (s *IterableSuite) TestMinMax() {
+	s.Run("ordered types", func() {
+		tests := []struct {
+			name     string
+			input    []int
+			min      int
+			max      int
+			expected bool
+		}{
+			{
+				name:     "empty slice",
+				input:    []int{},
+				min:      0,
+				max:      0,
+				expected: false,
+			},
+			{
+				name:     "single element",
+				input:    []int{7},
+				min:      7,
+				max:      7,
+				expected: true,
+			},
+			{
+				name:     "multiple elements",
+				input:    []int{3, -1, 8, 2},
+				min:      -1,
+				max:      8,
+				expected: true,
+			},
+			{
+				name:     "ties",
+				input:    []int{4, 1, 4, 1},
+				min:      1,
+				max:      4,
+				expected: true,
+			},
+		}
+
+		for _, tt := range tests {
+			s.Run(tt.name, func() {
+				iter := New(tt.input)
+
+				minimum, ok := Min(iter)
+				s.Equal(tt.expected, ok)
+				s.Equal(tt.min, minimum)
+
+				maximum, ok := Max(iter)
+				s.Equal(tt.expected, ok)
+				s.Equal(tt.max, maximum)
+
+				s.Equal(tt.input, iter.Collect())
+			})
+		}
+	})
+
+	s.Run("custom comparator", func() {
+		type player struct {
+			name  string
+			score int
+		}
+
+		byScore := func(a, b player) int { return cmp.Compare(a.score, b.score) }
+		iter := New([]player{
+			{name: "ann", score: 3},
+			{name: "bob", score: 1},
+			{name: "cid", score: 3},
+			{name: "dee", score: 1},
+		})
+
+		minimum, ok := iter.MinFunc(byScore)
+		s.True(ok)
+		s.Equal(player{name: "bob", score: 1}, minimum, "first minimal element should win ties")
+
+		maximum, ok := iter.MaxFunc(byScore)
+		s.True(ok)
+		s.Equal(player{name: "ann", score: 3}, maximum, "first maximal element should win ties")
+
+		_, ok = New([]player{}).MinFunc(byScore)
+		s.False(ok)
+
+		_, ok = New([]player{}).MaxFunc(byScore)
+		s.False(ok)
+	})
+}
+
+func (s *IterableSuite) TestSumAverage() {
+	s.Run("integers", func() {
+		tests := []struct {
+			name     string
+			input    []int
+			sum      int
+			average  float64
+			expected bool
+		}{
+			{
+				name:     "empty slice",
+				input:    []int{},
+				sum:      0,
+				average:  0,
+				expected: false,
+			},
+			{
+				name:     "single element",
+				input:    []int{5},
+				sum:      5,
+				average:  5,
+				expected: true,
+			},
+			{
+				name:     "multiple elements",
+				input:    []int{1, 2, 3, 4},
+				sum:      10,
+				average:  2.5,
+				expected: true,
+			},
+		}
+
+		for _, tt := range tests {
+			s.Run(tt.name, func() {
+				iter := New(tt.input)
+				s.Equal(tt.sum, Sum(iter))
+
+				average, ok := Average(iter)
+				s.Equal(tt.expected, ok)
+				s.InDelta(tt.average, average, 1e-9)
+			})
+		}
+	})
+
+	s.Run("floats", func() {
+		iter := New([]float64{0.5, 1.5, 4})
+		s.InDelta(6.0, Sum(iter), 1e-9)
+
+		average, ok := Average(iter)
+		s.True(ok)
+		s.InDelta(2.0, average, 1e-9)
+	})
+
+	s.Run("narrow integers do not overflow", func() {
+		average, ok := Average(New([]int8{100, 100}))
+		s.True(ok)
+		s.InDelta(100.0, average, 1e-9)
+
+		average, ok = Average(New([]uint8{200, 250, 255}))
+		s.True(ok)
+		s.InDelta(235.0, average, 1e-9)
+
+		s.InDeltaSlice(
+			[]float64{100, 100},
+			MovingAverage(New([]int8{100, 100, 100}), 2).Collect(),
+			1e-9,
+		)
+	})
+}
+
+func (s *IterableSuite) TestTakeDrop() {
+	tests := []struct {
+		name  string
+		input []int
+		n     int
+		take  []int
+		drop  []int
+	}{
+		{
+			name:  "empty slice",
+			input: []int{},
+			n:     2,
+			take:  []int{},
+			drop:  []int{},
+		},
+		{
+			name:  "zero",
+			input: []int{1, 2, 3},
+			n:     0,
+			take:  []int{},
+			drop:  []int{1, 2, 3},
+		},
+		{
+			name:  "within bounds",
+			input: []int{1, 2, 3, 4, 5},
+			n:     2,
+			take:  []int{1, 2},
+			drop:  []int{3, 4, 5},
+		},
+		{
+			name:  "exactly length",
+			input: []int{1, 2, 3},
+			n:     3,
+			take:  []int{1, 2, 3},
+			drop:  []int{},
+		},
+		{
+			name:  "greater than length",
+			input: []int{1, 2, 3},
+			n:     10,
+			take:  []int{1, 2, 3},
+			drop:  []int{},
+		},
+		{
+			name:  "negative",
+			input: []int{1, 2, 3},
+			n:     -1,
+			take:  []int{},
+			drop:  []int{1, 2, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.take, New(tt.input).Take(tt.n).Collect())
+			s.Equal(tt.drop, New(tt.input).Drop(tt.n).Collect())
+		})
+	}
+
+	s.Run("paging", func() {
+		input := []int{1, 2, 3, 4, 5, 6, 7}
+		s.Equal([]int{4, 5, 6}, New(input).Drop(3).Take(3).Collect())
+	})
+}
+
+func (s *IterableSuite) TestTakeDropWhile() {
+	isSmall := func(item int) bool { return item < 3 }
+
+	tests := []struct {
+		name      string
+		input     []int
+		takeWhile []int
+		dropWhile []int
+	}{
+		{
+			name:      "empty slice",
+			input:     []int{},
+			takeWhile: []int{},
+			dropWhile: []int{},
+		},
+		{
+			name:      "matching prefix",
+			input:     []int{1, 2, 3, 4, 1},
+			takeWhile: []int{1, 2},
+			dropWhile: []int{3, 4, 1},
+		},
+		{
+			name:      "matching suffix only",
+			input:     []int{5, 4, 1, 2},
+			takeWhile: []int{},
+			dropWhile: []int{5, 4, 1, 2},
+		},
+		{
+			name:      "no matches",
+			input:     []int{3, 4, 5},
+			takeWhile: []int{},
+			dropWhile: []int{3, 4, 5},
+		},
+		{
+			name:      "all match",
+			input:     []int{0, 1, 2},
+			takeWhile: []int{0, 1, 2},
+			dropWhile: []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.takeWhile, New(tt.input).TakeWhile(isSmall).Collect())
+			s.Equal(tt.dropWhile, New(tt.input).DropWhile(isSmall).Collect())
+		})
+	}
+
+	s.Run("stops at first mismatch", func() {
+		calls := 0
+		New([]int{1, 5, 1, 1}).TakeWhile(func(item int) bool {
+			calls++
+
+			return item < 3
+		})
+		s.Equal(2, calls)
+	})
+}
+
+func (s *IterableSuite) TestChunk() {
+	tests := []struct {
+		name     string
+		input    []int
+		size     int
+		expected [][]int
+	}{
+		{
+			name:     "empty slice",
+			input:    []int{},
+			size:     2,
+			expected: [][]int{},
+		},
+		{
+			name:     "exact division",
+			input:    []int{1, 2, 3, 4, 5, 6},
+			size:     2,
+			expected: [][]int{{1, 2}, {3, 4}, {5, 6}},
+		},
+		{
+			name:     "remainder chunk",
+			input:    []int{1, 2, 3, 4, 5},
+			size:     2,
+			expected: [][]int{{1, 2}, {3, 4}, {5}},
+		},
+		{
+			name:     "size larger than collection",
+			input:    []int{1, 2, 3},
+			size:     10,
+			expected: [][]int{{1, 2, 3}},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, Chunk(New(tt.input), tt.size))
+		})
+	}
+
+	s.Run("chunks are independent", func() {
+		iter := New([]int{1, 2, 3, 4})
+		chunks := Chunk(iter, 2)
+		chunks[0][0] = 100
+		s.Equal([]int{1, 2, 3, 4}, iter.Collect())
+	})
+
+	s.Run("non-positive size", func() {
+		s.Panics(func() { Chunk(New([]int{1, 2, 3}), 0) })
+		s.Panics(func() { Chunk(New([]int{1, 2, 3}), -1) })
+	})
+}
+
+func (s *IterableSuite) TestWindow() {
+	tests := []struct {
+		name     string
+		input    []int
+		size     int
+		expected [][]int
+	}{
+		{
+			name:     "empty slice",
+			input:    []int{},
+			size:     2,
+			expected: [][]int{},
+		},
+		{
+			name:     "overlapping windows",
+			input:    []int{1, 2, 3, 4, 5},
+			size:     3,
+			expected: [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}},
+		},
+		{
+			name:     "size equal to length",
+			input:    []int{1, 2, 3},
+			size:     3,
+			expected: [][]int{{1, 2, 3}},
+		},
+		{
+			name:     "size one",
+			input:    []int{1, 2, 3},
+			size:     1,
+			expected: [][]int{{1}, {2}, {3}},
+		},
+		{
+			name:     "size greater than length",
+			input:    []int{1, 2, 3},
+			size:     4,
+			expected: [][]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, Window(New(tt.input), tt.size))
+		})
+	}
+
+	s.Run("non-positive size", func() {
+		s.Panics(func() { Window(New([]int{1, 2, 3}), 0) })
+	})
+}
+
+func (s *IterableSuite) TestPartition() {
+	tests := []struct {
+		name      string
+		input     []int
+		predicate func(item int) bool
+		matched   []int
+		rest      []int
+	}{
+		{
+			name:      "empty slice",
+			input:     []int{},
+			predicate: func(int) bool { return true },
+			matched:   []int{},
+			rest:      []int{},
+		},
+		{
+			name:      "order preserved",
+			input:     []int{5, 2, 7, 4, 1, 6},
+			predicate: func(item int) bool { return item%2 == 0 },
+			matched:   []int{2, 4, 6},
+			rest:      []int{5, 7, 1},
+		},
+		{
+			name:      "all match",
+			input:     []int{1, 2, 3},
+			predicate: func(int) bool { return true },
+			matched:   []int{1, 2, 3},
+			rest:      []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			matched, rest := New(tt.input).Partition(tt.predicate)
+			s.Equal(tt.matched, matched.Collect())
+			s.Equal(tt.rest, rest.Collect())
+		})
+	}
+
+	s.Run("does not modify receiver", func() {
+		iter := New([]int{1, 2, 3, 4})
+		iter.Partition(func(item int) bool { return item > 2 })
+		s.Equal([]int{1, 2, 3, 4}, iter.Collect())
+	})
+}
+
+func (s *IterableSuite) TestGroupBy() {
+	s.Run("group by derived key", func() {
+		tests := []struct {
+			name     string
+			input    []int
+			expected map[bool][]int
+		}{
+			{
+				name:     "empty slice",
+				input:    []int{},
+				expected: map[bool][]int{},
+			},
+			{
+				name:  "parity",
+				input: []int{1, 2, 3, 4, 5, 6},
+				expected: map[bool][]int{
+					true:  {2, 4, 6},
+					false: {1, 3, 5},
+				},
+			},
+		}
+
+		for _, tt := range tests {
+			s.Run(tt.name, func() {
+				result := GroupBy(New(tt.input), func(item int) bool { return item%2 == 0 })
+				s.Equal(tt.expected, result)
+			})
+		}
+	})
+
+	s.Run("order preserved within buckets", func() {
+		type user struct {
+			name       string
+			department string
+		}
+
+		input := []user{
+			{name: "ann", department: "eng"},
+			{name: "bob", department: "ops"},
+			{name: "cid", department: "eng"},
+			{name: "dee", department: "ops"},
+			{name: "eve", department: "eng"},
+		}
+		iter := New(input)
+
+		result := GroupBy(iter, func(u user) string { return u.department })
+		s.Len(result, 2)
+		s.Equal([]user{input[0], input[2], input[4]}, result["eng"])
+		s.Equal([]user{input[1], input[3]}, result["ops"])
+		s.Equal(input, iter.Collect())
+	})
+}
+
+func (s *IterableSuite) TestFlatMap() {
+	tests := []struct {
+		name     string
+		input    []string
+		expected []string
+	}{
+		{
+			name:     "empty slice",
+			input:    []string{},
+			expected: []string{},
+		},
+		{
+			name:     "split into words",
+			input:    []string{"hello world", "go is fun"},
+			expected: []string{"hello", "world", "go", "is", "fun"},
+		},
+		{
+			name:     "empty results contribute nothing",
+			input:    []string{"", "one", "   ", "two three"},
+			expected: []string{"one", "two", "three"},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			result := FlatMap(New(tt.input), strings.Fields).Collect()
+			s.Equal(tt.expected, result)
+		})
+	}
+
+	s.Run("nil results", func() {
+		result := FlatMap(New([]int{1, 2, 3}), func(i int) []int {
+			if i == 2 {
+				return nil
+			}
+
+			return []int{i, i * 10}
+		}).Collect()
+		s.Equal([]int{1, 10, 3, 30}, result)
+	})
+}
+
+func (s *IterableSuite) TestGroupConsecutiveBy() {
+	parity := func(item int) bool { return item%2 == 0 }
+
+	tests := []struct {
+		name     string
+		input    []int
+		expected []Pair[bool, []int]
+	}{
+		{
+			name:     "empty slice",
+			input:    []int{},
+			expected: []Pair[bool, []int]{},
+		},
+		{
+			name:     "single group",
+			input:    []int{2, 4, 6},
+			expected: []Pair[bool, []int]{{First: true, Second: []int{2, 4, 6}}},
+		},
+		{
+			name:  "alternating runs",
+			input: []int{1, 3, 2, 4, 5},
+			expected: []Pair[bool, []int]{
+				{First: false, Second: []int{1, 3}},
+				{First: true, Second: []int{2, 4}},
+				{First: false, Second: []int{5}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, GroupConsecutiveBy(New(tt.input), parity))
+		})
+	}
+
+	s.Run("contrast with GroupBy", func() {
+		input := []string{"apple", "avocado", "banana", "apricot"}
+		firstLetter := func(item string) byte { return item[0] }
+
+		s.Equal(map[byte][]string{
+			'a': {"apple", "avocado", "apricot"},
+			'b': {"banana"},
+		}, GroupBy(New(input), firstLetter))
+		s.Equal([]Pair[byte, []string]{
+			{First: 'a', Second: []string{"apple", "avocado"}},
+			{First: 'b', Second: []string{"banana"}},
+			{First: 'a', Second: []string{"apricot"}},
+		}, GroupConsecutiveBy(New(input), firstLetter))
+	})
+}
+
+func (s *IterableSuite) TestFlatten() {
+	tests := []struct {
+		name     string
+		input    [][]int
+		expected []int
+	}{
+		{
+			name:     "empty slice",
+			input:    [][]int{},
+			expected: []int{},
+		},
+		{
+			name:     "mixed inner slices",
+			input:    [][]int{{1, 2}, nil, {}, {3}, {4, 5, 6}},
+			expected: []int{1, 2, 3, 4, 5, 6},
+		},
+		{
+			name:     "only empty inner slices",
+			input:    [][]int{nil, {}},
+			expected: []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, Flatten(tt.input).Collect())
+		})
+	}
+
+	s.Run("round trip with chunk", func() {
+		input := []int{1, 2, 3, 4, 5}
+		s.Equal(input, Flatten(Chunk(New(input), 2)).Collect())
+	})
+}
+
+func (s *IterableSuite) TestZip() {
+	tests := []struct {
+		name     string
+		keys     []string
+		values   []int
+		expected []Pair[string, int]
+	}{
+		{
+			name:     "both empty",
+			keys:     []string{},
+			values:   []int{},
+			expected: []Pair[string, int]{},
+		},
+		{
+			name:     "one empty",
+			keys:     []string{"a", "b"},
+			values:   []int{},
+			expected: []Pair[string, int]{},
+		},
+		{
+			name:   "equal lengths",
+			keys:   []string{"a", "b", "c"},
+			values: []int{1, 2, 3},
+			expected: []Pair[string, int]{
+				{First: "a", Second: 1},
+				{First: "b", Second: 2},
+				{First: "c", Second: 3},
+			},
+		},
+		{
+			name:   "unequal lengths",
+			keys:   []string{"a", "b", "c"},
+			values: []int{1, 2},
+			expected: []Pair[string, int]{
+				{First: "a", Second: 1},
+				{First: "b", Second: 2},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			result := Zip(New(tt.keys), New(tt.values)).Collect()
+			s.Equal(tt.expected, result)
+		})
+	}
+}
+
+func (s *IterableSuite) TestUnzip() {
+	s.Run("splits pairs", func() {
+		pairs := []Pair[string, int]{
+			{First: "a", Second: 1},
+			{First: "b", Second: 2},
+		}
+
+		firsts, seconds := Unzip(New(pairs))
+		s.Equal([]string{"a", "b"}, firsts.Collect())
+		s.Equal([]int{1, 2}, seconds.Collect())
+	})
+
+	s.Run("empty input", func() {
+		firsts, seconds := Unzip(New([]Pair[string, int]{}))
+		s.Equal([]string{}, firsts.Collect())
+		s.Equal([]int{}, seconds.Collect())
+	})
+
+	s.Run("round trip with zip", func() {
+		keys := []string{"x", "y", "z"}
+		values := []int{10, 20, 30}
+
+		firsts, seconds := Unzip(Zip(New(keys), New(values)))
+		s.Equal(keys, firsts.Collect())
+		s.Equal(values, seconds.Collect())
+	})
+}
+
+func (s *IterableSuite) TestConcat() {
+	tests := []struct {
+		name     string
+		first    []int
+		second   []int
+		expected []int
+	}{
+		{
+			name:     "both empty",
+			first:    []int{},
+			second:   []int{},
+			expected: []int{},
+		},
+		{
+			name:     "empty other",
+			first:    []int{1, 2},
+			second:   []int{},
+			expected: []int{1, 2},
+		},
+		{
+			name:     "empty receiver",
+			first:    []int{},
+			second:   []int{3, 4},
+			expected: []int{3, 4},
+		},
+		{
+			name:     "both populated",
+			first:    []int{1, 2},
+			second:   []int{3, 4},
+			expected: []int{1, 2, 3, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			result := New(tt.first).Concat(New(tt.second)).Collect()
+			s.Equal(tt.expected, result)
+		})
+	}
+
+	s.Run("does not alias other", func() {
+		other := New([]int{3, 4})
+		iter := New([]int{}).Concat(other)
+		iter.Mutate(func(i *int) { *i = 0 })
+		s.Equal([]int{3, 4}, other.Collect())
+	})
+}
+
+func (s *IterableSuite) TestAppendPrepend() {
+	s.Run("append", func() {
+		s.Equal([]int{1, 2, 3}, New([]int{1}).Append(2, 3).Collect())
+		s.Equal([]int{1}, New([]int{1}).Append().Collect())
+	})
+
+	s.Run("prepend", func() {
+		s.Equal([]int{2, 3, 1}, New([]int{1}).Prepend(2, 3).Collect())
+		s.Equal([]int{1}, New([]int{1}).Prepend().Collect())
+		s.Equal([]int{1, 2}, New([]int{}).Prepend(1, 2).Collect())
+	})
+
+	s.Run("chaining", func() {
+		result := New([]string{"b"}).Prepend("a").Append("c", "d").Collect()
+		s.Equal([]string{"a", "b", "c", "d"}, result)
+	})
+}
+
+func (s *IterableSuite) TestSeq() {
+	s.Run("range over seq", func() {
+		var visited []int
+		for item := range New([]int{1, 2, 3}).Seq() {
+			visited = append(visited, item)
+		}
+
+		s.Equal([]int{1, 2, 3}, visited)
+	})
+
+	s.Run("early break", func() {
+		var visited []int
+		for item := range New([]int{1, 2, 3}).Seq() {
+			if item == 2 {
+				break
+			}
+
+			visited = append(visited, item)
+		}
+
+		s.Equal([]int{1}, visited)
+	})
+
+	s.Run("from seq round trip", func() {
+		input := []string{"a", "b", "c"}
+		s.Equal(input, FromSeq(New(input).Seq()).Collect())
+	})
+
+	s.Run("from empty seq", func() {
+		s.Equal([]string{}, FromSeq(New([]string{}).Seq()).Collect())
+	})
+
+	s.Run("from standard library seq", func() {
+		keys := FromSeq(maps.Keys(map[string]int{"b": 2, "a": 1, "c": 3}))
+		s.Equal([]string{"a", "b", "c"}, Sort(keys).Collect())
+	})
+
+	s.Run("seq into standard library", func() {
+		s.Equal([]int{1, 2, 3}, slices.Collect(New([]int{1, 2, 3}).Seq()))
+	})
+}
+
+func (s *IterableSuite) TestSeq2() {
+	s.Run("full iteration", func() {
+		var (
+			indices []int
+			values  []string
+		)
+
+		for idx, item := range New([]string{"a", "b", "c"}).Seq2() {
+			indices = append(indices, idx)
+			values = append(values, item)
+		}
+
+		s.Equal([]int{0, 1, 2}, indices)
+		s.Equal([]string{"a", "b", "c"}, values)
+	})
+
+	s.Run("early termination", func() {
+		var indices []int
+		for idx := range New([]string{"a", "b", "c", "d"}).Seq2() {
+			if idx == 2 {
+				break
+			}
+
+			indices = append(indices, idx)
+		}
+
+		s.Equal([]int{0, 1}, indices)
+	})
+
+	s.Run("yield returning false is honored", func() {
+		calls := 0
+		New([]int{1, 2, 3}).Seq2()(func(int, int) bool {
+			calls++
+
+			return false
+		})
+		s.Equal(1, calls)
+	})
+}
+
+func (s *IterableSuite) TestTap() {
+	s.Run("empty slice", func() {
+		calls := 0
+		result := New([]int{}).Tap(func(int) { calls++ }).Collect()
+		s.Zero(calls)
+		s.Equal([]int{}, result)
+	})
+
+	s.Run("observes mid-pipeline", func() {
+		var observed []int
+
+		result := New([]int{1, 2, 3, 4}).
+			Filter(func(i int) bool { return i%2 == 0 }).
+			Tap(func(i int) { observed = append(observed, i) }).
+			Mutate(func(i *int) { *i *= 10 }).
+			Collect()
+
+		s.Equal([]int{2, 4}, observed)
+		s.Equal([]int{20, 40}, result)
+	})
+
+	s.Run("does not alter collection", func() {
+		iter := New([]string{"a", "b"})
+		s.Same(iter, iter.Tap(func(string) {}))
+		s.Equal([]string{"a", "b"}, iter.Collect())
+	})
+}
+
+func (s *IterableSuite) TestDistinctBy() {
+	type user struct {
+		id   int
+		name string
+	}
+
+	tests := []struct {
+		name     string
+		input    []user
+		expected []user
+	}{
+		{
+			name:     "empty slice",
+			input:    []user{},
+			expected: []user{},
+		},
+		{
+			name: "shared key keeps first",
+			input: []user{
+				{id: 1, name: "ann"},
+				{id: 2, name: "bob"},
+				{id: 1, name: "annie"},
+				{id: 3, name: "cid"},
+				{id: 2, name: "robert"},
+			},
+			expected: []user{
+				{id: 1, name: "ann"},
+				{id: 2, name: "bob"},
+				{id: 3, name: "cid"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			result := DistinctBy(New(tt.input), func(u user) int { return u.id }).Collect()
+			s.Equal(tt.expected, result)
+		})
+	}
+
+	s.Run("chaining with other operations", func() {
+		result := DistinctBy(New([]string{"apple", "avocado", "banana", "blueberry", "cherry"}),
+			func(s string) byte { return s[0] }).
+			Reverse().
+			Collect()
+		s.Equal([]string{"cherry", "banana", "apple"}, result)
+	})
+}
+
+func (s *IterableSuite) TestUniqueFunc() {
+	tests := []struct {
+		name     string
+		input    []string
+		expected []string
+	}{
+		{
+			name:     "empty slice",
+			input:    []string{},
+			expected: []string{},
+		},
+		{
+			name:     "case insensitive",
+			input:    []string{"Go", "go", "Rust", "GO", "rust", "Zig"},
+			expected: []string{"Go", "Rust", "Zig"},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			result := New(tt.input).
+				UniqueFunc(func(s string) any { return strings.ToLower(s) }).
+				Collect()
+			s.Equal(tt.expected, result)
+		})
+	}
+
+	s.Run("non-comparable key", func() {
+		s.Panics(func() {
+			New([]int{1, 2}).UniqueFunc(func(i int) any { return []int{i} })
+		})
+	})
+}
+
+func (s *IterableSuite) TestDedupeConsecutive() {
+	tests := []struct {
+		name        string
+		input       []int
+		consecutive []int
+		unique      []int
+	}{
+		{
+			name:        "empty slice",
+			input:       []int{},
+			consecutive: []int{},
+			unique:      []int{},
+		},
+		{
+			name:        "no adjacent duplicates",
+			input:       []int{1, 2, 1, 2},
+			consecutive: []int{1, 2, 1, 2},
+			unique:      []int{1, 2},
+		},
+		{
+			name:        "adjacent runs",
+			input:       []int{1, 1, 2, 1},
+			consecutive: []int{1, 2, 1},
+			unique:      []int{1, 2},
+		},
+		{
+			name:        "sorted input",
+			input:       []int{1, 1, 2, 2, 2, 3},
+			consecutive: []int{1, 2, 3},
+			unique:      []int{1, 2, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.consecutive, New(tt.input).DedupeConsecutive().Collect())
+			s.Equal(tt.unique, New(tt.input).Unique().Collect())
+		})
+	}
+}
+
+func (s *IterableSuite) TestCollectSorted() {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{
+			name:     "empty slice",
+			input:    []int{},
+			expected: []int{},
+		},
+		{
+			name:     "unsorted",
+			input:    []int{3, 1, 2, 1},
+			expected: []int{1, 1, 2, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, CollectSorted(New(tt.input)))
+		})
+	}
+
+	s.Run("iterable order is untouched", func() {
+		iter := New([]string{"c", "a", "b"})
+		sorted := CollectSorted(iter)
+		s.Equal([]string{"a", "b", "c"}, sorted)
+		s.Equal([]string{"c", "a", "b"}, iter.Collect())
+
+		sorted[0] = "z"
+		s.Equal([]string{"c", "a", "b"}, iter.Collect())
+	})
+
+	s.Run("nil collection", func() {
+		s.Equal([]int{}, CollectSorted(Wrap[int](nil)))
+	})
+}
+
+func (s *IterableSuite) TestSortBy() {
+	type employee struct {
+		name string
+		age  int
+	}
+
+	byAge := func(e employee) int { return e.age }
+
+	s.Run("empty slice", func() {
+		s.Equal([]employee{}, SortBy(New([]employee{}), byAge).Collect())
+	})
+
+	s.Run("stable for equal keys", func() {
+		input := []employee{
+			{name: "ann", age: 40},
+			{name: "bob", age: 30},
+			{name: "cid", age: 40},
+			{name: "dee", age: 30},
+			{name: "eve", age: 20},
+		}
+
+		result := SortBy(New(input), byAge).Collect()
+		s.Equal([]employee{
+			{name: "eve", age: 20},
+			{name: "bob", age: 30},
+			{name: "dee", age: 30},
+			{name: "ann", age: 40},
+			{name: "cid", age: 40},
+		}, result)
+	})
+
+	s.Run("string keys", func() {
+		result := SortBy(New([]string{"banana", "Apple", "cherry"}), strings.ToLower).Collect()
+		s.Equal([]string{"Apple", "banana", "cherry"}, result)
+	})
+}
+
+func (s *IterableSuite) TestIsSorted() {
+	tests := []struct {
+		name     string
+		input    []int
+		expected bool
+	}{
+		{
+			name:     "empty slice",
+			input:    []int{},
+			expected: true,
+		},
+		{
+			name:     "single element",
+			input:    []int{42},
+			expected: true,
+		},
+		{
+			name:     "sorted with duplicates",
+			input:    []int{1, 2, 2, 3},
+			expected: true,
+		},
+		{
+			name:     "unsorted",
+			input:    []int{1, 3, 2},
+			expected: false,
+		},
+		{
+			name:     "descending",
+			input:    []int{3, 2, 1},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, IsSorted(New(tt.input)))
+			s.Equal(tt.expected, New(tt.input).IsSortedFunc(cmp.Compare[int]))
+		})
+	}
+
+	s.Run("custom ordering", func() {
+		byLength := func(a, b string) int { return cmp.Compare(len(a), len(b)) }
+		s.True(New([]string{"go", "abc", "test"}).IsSortedFunc(byLength))
+		s.False(New([]string{"test", "go"}).IsSortedFunc(byLength))
+	})
+
+	s.Run("after sort", func() {
+		s.True(IsSorted(Sort(New([]int{3, 1, 2}))))
+	})
+}
+
+func (s *IterableSuite) TestBinarySearch() {
+	tests := []struct {
+		name          string
+		input         []int
+		target        int
+		expectedIndex int
+		expectedFound bool
+	}{
+		{
+			name:          "empty slice",
+			input:         []int{},
+			target:        1,
+			expectedIndex: 0,
+			expectedFound: false,
+		},
+		{
+			name:          "found",
+			input:         []int{1, 3, 5, 7},
+			target:        5,
+			expectedIndex: 2,
+			expectedFound: true,
+		},
+		{
+			name:          "not found in middle",
+			input:         []int{1, 3, 5, 7},
+			target:        4,
+			expectedIndex: 2,
+			expectedFound: false,
+		},
+		{
+			name:          "not found before first",
+			input:         []int{1, 3, 5, 7},
+			target:        0,
+			expectedIndex: 0,
+			expectedFound: false,
+		},
+		{
+			name:          "not found after last",
+			input:         []int{1, 3, 5, 7},
+			target:        9,
+			expectedIndex: 4,
+			expectedFound: false,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			index, found := BinarySearch(New(tt.input), tt.target)
+			s.Equal(tt.expectedIndex, index)
+			s.Equal(tt.expectedFound, found)
+
+			index, found = New(tt.input).BinarySearchFunc(tt.target, cmp.Compare[int])
+			s.Equal(tt.expectedIndex, index)
+			s.Equal(tt.expectedFound, found)
+		})
+	}
+
+	s.Run("custom ordering", func() {
+		descending := func(a, b int) int { return cmp.Compare(b, a) }
+		iter := New([]int{9, 7, 5, 3})
+
+		index, found := iter.BinarySearchFunc(7, descending)
+		s.Equal(1, index)
+		s.True(found)
+
+		index, found = iter.BinarySearchFunc(4, descending)
+		s.Equal(3, index)
+		s.False(found)
+	})
+}
+
+func (s *IterableSuite) TestAt() {
+	tests := []struct {
+		name     string
+		input    []string
+		index    int
+		expected string
+		found    bool
+	}{
+		{
+			name:     "empty slice",
+			input:    []string{},
+			index:    0,
+			expected: "",
+			found:    false,
+		},
+		{
+			name:     "first element",
+			input:    []string{"a", "b", "c"},
+			index:    0,
+			expected: "a",
+			found:    true,
+		},
+		{
+			name:     "last element",
+			input:    []string{"a", "b", "c"},
+			index:    2,
+			expected: "c",
+			found:    true,
+		},
+		{
+			name:     "past the end",
+			input:    []string{"a", "b", "c"},
+			index:    3,
+			expected: "",
+			found:    false,
+		},
+		{
+			name:     "negative from end",
+			input:    []string{"a", "b", "c"},
+			index:    -1,
+			expected: "c",
+			found:    true,
+		},
+		{
+			name:     "negative first element",
+			input:    []string{"a", "b", "c"},
+			index:    -3,
+			expected: "a",
+			found:    true,
+		},
+		{
+			name:     "negative out of range",
+			input:    []string{"a", "b", "c"},
+			index:    -4,
+			expected: "",
+			found:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			result, found := New(tt.input).At(tt.index)
+			s.Equal(tt.expected, result)
+			s.Equal(tt.found, found)
+		})
+	}
+}
+
+func (s *IterableSuite) TestFirstLast() {
+	tests := []struct {
+		name  string
+		input []int
+		first int
+		last  int
+		found bool
+	}{
+		{
+			name:  "empty slice",
+			input: []int{},
+			first: 0,
+			last:  0,
+			found: false,
+		},
+		{
+			name:  "single element",
+			input: []int{7},
+			first: 7,
+			last:  7,
+			found: true,
+		},
+		{
+			name:  "multiple elements",
+			input: []int{1, 2, 3},
+			first: 1,
+			last:  3,
+			found: true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			iter := New(tt.input)
+
+			first, found := iter.First()
+			s.Equal(tt.first, first)
+			s.Equal(tt.found, found)
+
+			last, found := iter.Last()
+			s.Equal(tt.last, last)
+			s.Equal(tt.found, found)
+		})
+	}
+
+	s.Run("after filter", func() {
+		result, found := New([]int{1, 2, 3}).Filter(func(i int) bool { return i > 5 }).First()
+		s.False(found)
+		s.Zero(result)
+	})
+}
+
+func (s *IterableSuite) TestSlice() {
+	tests := []struct {
+		name     string
+		input    []int
+		start    int
+		end      int
+		expected []int
+	}{
+		{
+			name:     "empty slice",
+			input:    []int{},
+			start:    0,
+			end:      2,
+			expected: []int{},
+		},
+		{
+			name:     "full range",
+			input:    []int{1, 2, 3, 4},
+			start:    0,
+			end:      4,
+			expected: []int{1, 2, 3, 4},
+		},
+		{
+			name:     "inner range",
+			input:    []int{1, 2, 3, 4},
+			start:    1,
+			end:      3,
+			expected: []int{2, 3},
+		},
+		{
+			name:     "clamped bounds",
+			input:    []int{1, 2, 3, 4},
+			start:    -5,
+			end:      10,
+			expected: []int{1, 2, 3, 4},
+		},
+		{
+			name:     "start past end of collection",
+			input:    []int{1, 2, 3, 4},
+			start:    6,
+			end:      8,
+			expected: []int{},
+		},
+		{
+			name:     "reversed bounds",
+			input:    []int{1, 2, 3, 4},
+			start:    3,
+			end:      1,
+			expected: []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			result := New(tt.input).Slice(tt.start, tt.end).Collect()
+			s.Equal(tt.expected, result)
+		})
+	}
+}
+
+func (s *IterableSuite) TestClone() {
+	s.Run("copies elements", func() {
+		s.Equal([]int{}, New([]int{}).Clone().Collect())
+		s.Equal([]int{1, 2, 3}, New([]int{1, 2, 3}).Clone().Collect())
+	})
+
+	s.Run("mutating clone leaves original unchanged", func() {
+		original := New([]int{1, 2, 3})
+		clone := original.Clone().
+			Mutate(func(i *int) { *i *= 10 }).
+			Reverse()
+
+		s.Equal([]int{30, 20, 10}, clone.Collect())
+		s.Equal([]int{1, 2, 3}, original.Collect())
+	})
+
+	s.Run("mutating original leaves clone unchanged", func() {
+		input := []int{1, 2, 3}
+		original := Wrap(input)
+		clone := original.Clone()
+		original.Mutate(func(i *int) { *i = 0 })
+
+		s.Equal([]int{1, 2, 3}, clone.Collect())
+	})
+}
+
+func (s *IterableSuite) TestTee() {
+	s.Run("copies elements", func() {
+		left, right := New([]int{}).Tee()
+		s.Equal([]int{}, left.Collect())
+		s.Equal([]int{}, right.Collect())
+	})
+
+	s.Run("branches are independent", func() {
+		source := New([]int{1, 2, 3, 4, 5, 6})
+		evens, odds := source.Tee()
+		evens.Filter(func(i int) bool { return i%2 == 0 })
+		odds.Filter(func(i int) bool { return i%2 == 1 }).Mutate(func(i *int) { *i *= 10 })
+
+		s.Equal([]int{2, 4, 6}, evens.Collect())
+		s.Equal([]int{10, 30, 50}, odds.Collect())
+		s.Equal([]int{1, 2, 3, 4, 5, 6}, source.Collect())
+	})
+
+	s.Run("branches do not share backing data", func() {
+		left, right := New([]int{1, 2, 3}).Tee()
+		left.Mutate(func(i *int) { *i = 0 })
+		s.Equal([]int{1, 2, 3}, right.Collect())
+	})
+}
+
+func (s *IterableSuite) TestClearIsEmpty() {
+	s.Run("is empty", func() {
+		s.True(New([]int{}).IsEmpty())
+		s.True(New[int](nil).IsEmpty())
+		s.False(New([]int{1}).IsEmpty())
+		s.True(New([]int{1, 2}).Filter(func(int) bool { return false }).IsEmpty())
+	})
+
+	s.Run("clear", func() {
+		iter := New([]int{1, 2, 3})
+		capacity := cap(iter.Collect())
+
+		iter.Clear()
+		s.Equal(0, iter.Len())
+		s.True(iter.IsEmpty())
+		s.Equal(capacity, cap(iter.Collect()))
+	})
+
+	s.Run("append after clear", func() {
+		result := New([]int{1, 2, 3}).Clear().Append(4, 5).Collect()
+		s.Equal([]int{4, 5}, result)
+	})
+
+	s.Run("clear empty", func() {
+		s.True(New([]int{}).Clear().IsEmpty())
+	})
+}
+
+func (s *IterableSuite) TestRemove() {
+	s.Run("remove value", func() {
+		tests := []struct {
+			name     string
+			input    []int
+			target   int
+			expected []int
+		}{
+			{
+				name:     "empty slice",
+				input:    []int{},
+				target:   1,
+				expected: []int{},
+			},
+			{
+				name:     "non-existent value",
+				input:    []int{1, 2, 3},
+				target:   4,
+				expected: []int{1, 2, 3},
+			},
+			{
+				name:     "all duplicates",
+				input:    []int{2, 1, 2, 3, 2},
+				target:   2,
+				expected: []int{1, 3},
+			},
+		}
+
+		for _, tt := range tests {
+			s.Run(tt.name, func() {
+				s.Equal(tt.expected, New(tt.input).Remove(tt.target).Collect())
+			})
+		}
+	})
+
+	s.Run("remove at index", func() {
+		tests := []struct {
+			name     string
+			input    []int
+			index    int
+			expected []int
+		}{
+			{
+				name:     "empty slice",
+				input:    []int{},
+				index:    0,
+				expected: []int{},
+			},
+			{
+				name:     "first index",
+				input:    []int{1, 2, 3},
+				index:    0,
+				expected: []int{2, 3},
+			},
+			{
+				name:     "last index",
+				input:    []int{1, 2, 3},
+				index:    2,
+				expected: []int{1, 2},
+			},
+			{
+				name:     "out of range",
+				input:    []int{1, 2, 3},
+				index:    3,
+				expected: []int{1, 2, 3},
+			},
+			{
+				name:     "negative index",
+				input:    []int{1, 2, 3},
+				index:    -1,
+				expected: []int{1, 2, 3},
+			},
+		}
+
+		for _, tt := range tests {
+			s.Run(tt.name, func() {
+				s.Equal(tt.expected, New(tt.input).RemoveAt(tt.index).Collect())
+			})
+		}
+	})
+}
+
+func (s *IterableSuite) TestInsert() {
+	tests := []struct {
+		name     string
+		input    []int
+		index    int
+		items    []int
+		expected []int
+	}{
+		{
+			name:     "empty slice",
+			input:    []int{},
+			index:    0,
+			items:    []int{1, 2},
+			expected: []int{1, 2},
+		},
+		{
+			name:     "front",
+			input:    []int{3, 4},
+			index:    0,
+			items:    []int{1, 2},
+			expected: []int{1, 2, 3, 4},
+		},
+		{
+			name:     "middle",
+			input:    []int{1, 4},
+			index:    1,
+			items:    []int{2, 3},
+			expected: []int{1, 2, 3, 4},
+		},
+		{
+			name:     "append at end",
+			input:    []int{1, 2},
+			index:    2,
+			items:    []int{3},
+			expected: []int{1, 2, 3},
+		},
+		{
+			name:     "beyond end is clamped",
+			input:    []int{1, 2},
+			index:    10,
+			items:    []int{3},
+			expected: []int{1, 2, 3},
+		},
+		{
+			name:     "negative is clamped",
+			input:    []int{2, 3},
+			index:    -5,
+			items:    []int{1},
+			expected: []int{1, 2, 3},
+		},
+		{
+			name:     "no items",
+			input:    []int{1, 2},
+			index:    1,
+			items:    nil,
+			expected: []int{1, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			result := New(tt.input).Insert(tt.index, tt.items...).Collect()
+			s.Equal(tt.expected, result)
+		})
+	}
+}
+
+func (s *IterableSuite) TestJoin() {
+	tests := []struct {
+		name     string
+		input    []string
+		sep      string
+		expected string
+	}{
+		{
+			name:     "empty slice",
+			input:    []string{},
+			sep:      ", ",
+			expected: "",
+		},
+		{
+			name:     "single element",
+			input:    []string{"a"},
+			sep:      ", ",
+			expected: "a",
+		},
+		{
+			name:     "multiple elements",
+			input:    []string{"a", "b", "c"},
+			sep:      ", ",
+			expected: "a, b, c",
+		},
+		{
+			name:     "empty separator",
+			input:    []string{"a", "b", "c"},
+			sep:      "",
+			expected: "abc",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, Join(New(tt.input), tt.sep))
+			s.Equal(strings.Join(tt.input, tt.sep), Join(New(tt.input), tt.sep))
+		})
+	}
+
+	s.Run("named string type", func() {
+		type color string
+
+		s.Equal("red|green", Join(New([]color{"red", "green"}), "|"))
+	})
+}
+
+func (s *IterableSuite) TestJoinBy() {
+	tests := []struct {
+		name     string
+		input    []int
+		expected string
+	}{
+		{
+			name:     "empty slice",
+			input:    []int{},
+			expected: "",
+		},
+		{
+			name:     "single element",
+			input:    []int{7},
+			expected: "7",
+		},
+		{
+			name:     "multiple elements",
+			input:    []int{1, 2, 3},
+			expected: "1, 2, 3",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, JoinBy(New(tt.input), ", ", strconv.Itoa))
+		})
+	}
+
+	s.Run("custom formatter", func() {
+		hex := func(i int) string { return fmt.Sprintf("%#02x", i) }
+		s.Equal("0x0a:0xff:0x01", JoinBy(New([]int{10, 255, 1}), ":", hex))
+	})
+}
+
+func (s *IterableSuite) TestToMap() {
+	type user struct {
+		id   int
+		name string
+	}
+
+	byID := func(u user) (int, string) { return u.id, u.name }
+
+	tests := []struct {
+		name     string
+		input    []user
+		expected map[int]string
+	}{
+		{
+			name:     "empty slice",
+			input:    []user{},
+			expected: map[int]string{},
+		},
+		{
+			name:     "distinct keys",
+			input:    []user{{id: 1, name: "ann"}, {id: 2, name: "bob"}},
+			expected: map[int]string{1: "ann", 2: "bob"},
+		},
+		{
+			name:     "later duplicates overwrite",
+			input:    []user{{id: 1, name: "ann"}, {id: 2, name: "bob"}, {id: 1, name: "annie"}},
+			expected: map[int]string{1: "annie", 2: "bob"},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, ToMap(New(tt.input), byID))
+		})
+	}
+}
+
+func (s *IterableSuite) TestTryMap() {
+	s.Run("all succeed", func() {
+		result, err := TryMap(New([]string{"1", "2", "3"}), strconv.Atoi)
+		s.Require().NoError(err)
+		s.Equal([]int{1, 2, 3}, result.Collect())
+	})
+
+	s.Run("empty slice", func() {
+		result, err := TryMap(New([]string{}), strconv.Atoi)
+		s.Require().NoError(err)
+		s.Equal([]int{}, result.Collect())
+	})
+
+	s.Run("stops at first error", func() {
+		errBoom := errors.New("boom")
+
+		calls := 0
+		result, err := TryMap(New([]int{1, 2, 3, 4, 5}), func(i int) (string, error) {
+			calls++
+			if i == 3 {
+				return "", errBoom
+			}
+
+			return strconv.Itoa(i), nil
+		})
+		s.Require().ErrorIs(err, errBoom)
+		s.Nil(result)
+		s.Equal(3, calls)
+	})
+
+	s.Run("parse error", func() {
+		_, err := TryMap(New([]string{"1", "two", "3"}), strconv.Atoi)
+
+		var numErr *strconv.NumError
+		s.Require().ErrorAs(err, &numErr)
+		s.Equal("two", numErr.Num)
+	})
+}
+
+func (s *IterableSuite) TestMapErr() {
+	s.Run("all succeed", func() {
+		result, errs := MapErr(New([]string{"1", "2", "3"}), strconv.Atoi)
+		s.Equal([]int{1, 2, 3}, result.Collect())
+		s.Equal([]error{nil, nil, nil}, errs)
+	})
+
+	s.Run("empty slice", func() {
+		result, errs := MapErr(New([]string{}), strconv.Atoi)
+		s.Equal([]int{}, result.Collect())
+		s.Empty(errs)
+	})
+
+	s.Run("collects every error aligned by index", func() {
+		calls := 0
+		result, errs := MapErr(New([]string{"1", "x", "3", "y"}), func(item string) (int, error) {
+			calls++
+
+			return strconv.Atoi(item)
+		})
+		s.Equal(4, calls)
+		s.Equal([]int{1, 0, 3, 0}, result.Collect())
+		s.Require().Len(errs, 4)
+		s.NoError(errs[0])
+		s.Require().ErrorIs(errs[1], strconv.ErrSyntax)
+		s.NoError(errs[2])
+		s.Require().ErrorIs(errs[3], strconv.ErrSyntax)
+	})
+}
+
+func (s *IterableSuite) TestTryForEach() {
+	errOdd := errors.New("odd")
+	validate := func(i int) error {
+		if i%2 != 0 {
+			return fmt.Errorf("%d: %w", i, errOdd)
+		}
+
+		return nil
+	}
+
+	s.Run("all succeed", func() {
+		s.NoError(New([]int{2, 4, 6}).TryForEach(validate))
+		s.NoError(New([]int{2, 4, 6}).TryForEachAll(validate))
+	})
+
+	s.Run("empty slice", func() {
+		s.NoError(New([]int{}).TryForEach(validate))
+		s.NoError(New([]int{}).TryForEachAll(validate))
+	})
+
+	s.Run("stops on first error", func() {
+		var visited []int
+
+		err := New([]int{2, 3, 4, 5}).TryForEach(func(i int) error {
+			visited = append(visited, i)
+
+			return validate(i)
+		})
+		s.Require().ErrorIs(err, errOdd)
+		s.Equal("3: odd", err.Error())
+		s.Equal([]int{2, 3}, visited)
+	})
+
+	s.Run("collects all errors", func() {
+		var visited []int
+
+		err := New([]int{2, 3, 4, 5}).TryForEachAll(func(i int) error {
+			visited = append(visited, i)
+
+			return validate(i)
+		})
+		s.Require().ErrorIs(err, errOdd)
+		s.Equal("3: odd\n5: odd", err.Error())
+		s.Equal([]int{2, 3, 4, 5}, visited)
+	})
+}
+
+func (s *IterableSuite) TestScan() {
+	s.Run("running sums", func() {
+		tests := []struct {
+			name     string
+			input    []int
+			initial  int
+			expected []int
+		}{
+			{
+				name:     "empty slice",
+				input:    []int{},
+				initial:  10,
+				expected: []int{},
+			},
+			{
+				name:     "prefix sums",
+				input:    []int{1, 2, 3},
+				initial:  0,
+				expected: []int{1, 3, 6},
+			},
+			{
+				name:     "non-zero seed",
+				input:    []int{1, 2, 3},
+				initial:  10,
+				expected: []int{11, 13, 16},
+			},
+		}
+
+		for _, tt := range tests {
+			s.Run(tt.name, func() {
+				result := Scan(New(tt.input), tt.initial, func(acc, item int) int {
+					return acc + item
+				}).Collect()
+				s.Equal(tt.expected, result)
+			})
+		}
+	})
+
+	s.Run("string building", func() {
+		result := Scan(New([]string{"a", "b", "c"}), "", func(acc, item string) string {
+			return acc + item
+		}).Collect()
+		s.Equal([]string{"a", "ab", "abc"}, result)
+	})
+
+	s.Run("last element matches reduce", func() {
+		input := New([]int{4, 8, 15, 16, 23, 42})
+		add := func(acc, item int) int { return acc + item }
+
+		last, ok := Scan(input, 0, add).Last()
+		s.True(ok)
+		s.Equal(Reduce(input, 0, add), last)
+	})
+}
+
+func (s *IterableSuite) TestRepeat() {
+	tests := []struct {
+		name     string
+		value    string
+		n        int
+		expected []string
+	}{
+		{
+			name:     "zero",
+			value:    "x",
+			n:        0,
+			expected: []string{},
+		},
+		{
+			name:     "negative",
+			value:    "x",
+			n:        -2,
+			expected: []string{},
+		},
+		{
+			name:     "one",
+			value:    "x",
+			n:        1,
+			expected: []string{"x"},
+		},
+		{
+			name:     "several",
+			value:    "x",
+			n:        3,
+			expected: []string{"x", "x", "x"},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, Repeat(tt.value, tt.n).Collect())
+		})
+	}
+}
+
+func (s *IterableSuite) TestFill() {
+	s.Equal([]int{}, New([]int{}).Fill(7).Collect())
+	s.Equal([]int{7, 7, 7}, New([]int{1, 2, 3}).Fill(7).Collect())
+	s.Equal([]int{0, 0}, New([]int{1, 2, 3}).Take(2).Fill(0).Collect())
+}
+
+func (s *IterableSuite) TestReplace() {
+	tests := []struct {
+		name     string
+		input    []int
+		oldValue int
+		newValue int
+		expected []int
+	}{
+		{
+			name:     "empty slice",
+			input:    []int{},
+			oldValue: 1,
+			newValue: 2,
+			expected: []int{},
+		},
+		{
+			name:     "missing value is a no-op",
+			input:    []int{1, 2, 3},
+			oldValue: 4,
+			newValue: 0,
+			expected: []int{1, 2, 3},
+		},
+		{
+			name:     "replaces duplicates",
+			input:    []int{1, 2, 1, 3, 1},
+			oldValue: 1,
+			newValue: 9,
+			expected: []int{9, 2, 9, 3, 9},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			result := New(tt.input).Replace(tt.oldValue, tt.newValue).Collect()
+			s.Equal(tt.expected, result)
+		})
+	}
+
+	s.Run("replace func", func() {
+		negative := func(i int) bool { return i < 0 }
+		s.Equal([]int{1, 0, 2, 0}, New([]int{1, -5, 2, -1}).ReplaceFunc(negative, 0).Collect())
+		s.Equal([]int{1, 2}, New([]int{1, 2}).ReplaceFunc(negative, 0).Collect())
+	})
+
+	s.Run("chains", func() {
+		result := New([]string{"a", "", "b", ""}).
+			Replace("", "-").
+			ReplaceFunc(func(s string) bool { return s == "b" }, "B").
+			Collect()
+		s.Equal([]string{"a", "-", "B", "-"}, result)
+	})
+}
+
+func (s *IterableSuite) TestRange() {
+	tests := []struct {
+		name     string
+		start    int
+		end      int
+		step     int
+		expected []int
+	}{
+		{
+			name:     "ascending",
+			start:    0,
+			end:      5,
+			step:     1,
+			expected: []int{0, 1, 2, 3, 4},
+		},
+		{
+			name:     "descending",
+			start:    5,
+			end:      0,
+			step:     -1,
+			expected: []int{5, 4, 3, 2, 1},
+		},
+		{
+			name:     "step greater than one",
+			start:    1,
+			end:      10,
+			step:     3,
+			expected: []int{1, 4, 7},
+		},
+		{
+			name:     "descending step greater than one",
+			start:    10,
+			end:      1,
+			step:     -4,
+			expected: []int{10, 6, 2},
+		},
+		{
+			name:     "empty range",
+			start:    3,
+			end:      3,
+			step:     1,
+			expected: []int{},
+		},
+		{
+			name:     "step in wrong direction",
+			start:    0,
+			end:      5,
+			step:     -1,
+			expected: []int{},
+		},
+		{
+			name:     "zero step",
+			start:    0,
+			end:      5,
+			step:     0,
+			expected: []int{},
+		},
+		{
+			name:     "very large step",
+			start:    1,
+			end:      10,
+			step:     math.MaxInt,
+			expected: []int{1},
+		},
+		{
+			name:     "very large negative step",
+			start:    -1,
+			end:      -10,
+			step:     math.MinInt,
+			expected: []int{-1},
+		},
+		{
+			name:     "full int range",
+			start:    math.MinInt,
+			end:      math.MaxInt,
+			step:     math.MaxInt,
+			expected: []int{math.MinInt, -1, math.MaxInt - 1},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			result := Range(tt.start, tt.end, tt.step).Collect()
+			s.Equal(tt.expected, result)
+			s.Equal(len(tt.expected), cap(result))
+		})
+	}
+}
+
+func (s *IterableSuite) TestGenerate() {
+	square := func(i int) int { return i * i }
+
+	s.Equal([]int{}, Generate(0, square).Collect())
+	s.Equal([]int{}, Generate(-1, square).Collect())
+	s.Equal([]int{0, 1, 4, 9, 16}, Generate(5, square).Collect())
+	s.Equal([]string{"row-0", "row-1"}, Generate(2, func(i int) string {
+		return "row-" + strconv.Itoa(i)
+	}).Collect())
+}
+
+func (s *IterableSuite) TestIterate() {
+	double := func(prev int) int { return prev * 2 }
+
+	s.Run("boundaries", func() {
+		s.Equal([]int{}, Iterate(1, 0, double).Collect())
+		s.Equal([]int{}, Iterate(1, -1, double).Collect())
+		s.Equal([]int{1}, Iterate(1, 1, double).Collect())
+	})
+
+	s.Run("powers of two", func() {
+		s.Equal([]int{1, 2, 4, 8, 16}, Iterate(1, 5, double).Collect())
+	})
+
+	s.Run("fibonacci", func() {
+		type state = Pair[int, int]
+
+		pairs := Iterate(state{First: 0, Second: 1}, 10, func(prev state) state {
+			return state{First: prev.Second, Second: prev.First + prev.Second}
+		})
+
+		result := Map(pairs, func(p state) int { return p.First }).Collect()
+		s.Equal([]int{0, 1, 1, 2, 3, 5, 8, 13, 21, 34}, result)
+	})
+
+	s.Run("fn called only for following elements", func() {
+		calls := 0
+		Iterate(1, 4, func(prev int) int {
+			calls++
+
+			return prev + 1
+		})
+		s.Equal(3, calls)
+	})
+}
+
+func (s *IterableSuite) TestEnumerate() {
+	s.Run("empty slice", func() {
+		s.Equal([]IndexedItem[string]{}, Enumerate(New([]string{})).Collect())
+	})
+
+	s.Run("zero based indices", func() {
+		result := Enumerate(New([]string{"a", "b", "c"})).Collect()
+		s.Equal([]IndexedItem[string]{
+			{Index: 0, Value: "a"},
+			{Index: 1, Value: "b"},
+			{Index: 2, Value: "c"},
+		}, result)
+	})
+
+	s.Run("contiguous after filter", func() {
+		filtered := New([]int{1, 2, 3, 4, 5, 6}).Filter(func(i int) bool { return i%2 == 0 })
+		result := Enumerate(filtered).Collect()
+		s.Equal([]IndexedItem[int]{
+			{Index: 0, Value: 2},
+			{Index: 1, Value: 4},
+			{Index: 2, Value: 6},
+		}, result)
+	})
+}
+
+func (s *IterableSuite) TestString() {
+	tests := []struct {
+		name     string
+		iter     fmt.Stringer
+		expected string
+	}{
+		{
+			name:     "empty slice",
+			iter:     New([]int{}),
+			expected: "[]",
+		},
+		{
+			name:     "nil slice",
+			iter:     New[int](nil),
+			expected: "[]",
+		},
+		{
+			name:     "integers",
+			iter:     New([]int{1, 2, 3}),
+			expected: "[1 2 3]",
+		},
+		{
+			name:     "strings",
+			iter:     New([]string{"a", "b", "c"}),
+			expected: "[a b c]",
+		},
+		{
+			name:     "pairs",
+			iter:     Zip(New([]string{"a"}), New([]int{1})),
+			expected: "[{a 1}]",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, tt.iter.String())
+		})
+	}
+
+	s.Run("formatting verbs", func() {
+		s.Equal("values: [1 2]", fmt.Sprintf("values: %v", New([]int{1, 2})))
+	})
+
+	s.Run("large slice is not truncated", func() {
+		result := Range(0, 1000, 1).String()
+		s.True(strings.HasPrefix(result, "[0 1 2 "))
+		s.True(strings.HasSuffix(result, " 998 999]"))
+		s.Len(strings.Fields(result), 1000)
+	})
+}
+
+func (s *IterableSuite) TestEqual() {
+	tests := []struct {
+		name      string
+		a         []int
+		b         []int
+		equal     bool
+		unordered bool
+	}{
+		{
+			name:      "both empty",
+			a:         []int{},
+			b:         []int{},
+			equal:     true,
+			unordered: true,
+		},
+		{
+			name:      "equal",
+			a:         []int{1, 2, 3},
+			b:         []int{1, 2, 3},
+			equal:     true,
+			unordered: true,
+		},
+		{
+			name:      "different length",
+			a:         []int{1, 2, 3},
+			b:         []int{1, 2},
+			equal:     false,
+			unordered: false,
+		},
+		{
+			name:      "same elements different order",
+			a:         []int{1, 2, 3},
+			b:         []int{3, 1, 2},
+			equal:     false,
+			unordered: true,
+		},
+		{
+			name:      "different multiplicities",
+			a:         []int{1, 1, 2},
+			b:         []int{1, 2, 2},
+			equal:     false,
+			unordered: false,
+		},
+		{
+			name:      "different elements",
+			a:         []int{1, 2, 3},
+			b:         []int{1, 2, 4},
+			equal:     false,
+			unordered: false,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			a, b := New(tt.a), New(tt.b)
+			s.Equal(tt.equal, a.Equal(b))
+			s.Equal(tt.equal, b.Equal(a))
+			s.Equal(tt.unordered, a.EqualUnordered(b))
+			s.Equal(tt.unordered, b.EqualUnordered(a))
+		})
+	}
+}
+
+func (s *IterableSuite) TestCollectInto() {
+	s.Run("appends rather than overwrites", func() {
+		dst := []int{1, 2}
+		result := New([]int{3, 4}).CollectInto(dst)
+		s.Equal([]int{1, 2, 3, 4}, result)
+		s.Equal([]int{1, 2}, dst)
+	})
+
+	s.Run("nil destination", func() {
+		s.Equal([]int{1, 2}, New([]int{1, 2}).CollectInto(nil))
+	})
+
+	s.Run("empty collection", func() {
+		dst := []int{1}
+		s.Equal([]int{1}, New([]int{}).CollectInto(dst))
+	})
+
+	s.Run("reuses capacity", func() {
+		buf := make([]int, 0, 8)
+		for _, input := range [][]int{{1, 2, 3}, {4, 5}} {
+			buf = New(input).CollectInto(buf[:0])
+			s.Equal(input, buf)
+			s.Equal(8, cap(buf))
+		}
+	})
+
+	s.Run("does not alias collection", func() {
+		iter := New([]int{1, 2})
+		result := iter.CollectInto(nil)
+		result[0] = 100
+		s.Equal([]int{1, 2}, iter.Collect())
+	})
+}
+
+func (s *IterableSuite) TestCollectN() {
+	tests := []struct {
+		name     string
+		input    []int
+		n        int
+		expected []int
+	}{
+		{
+			name:     "empty slice",
+			input:    []int{},
+			n:        3,
+			expected: []int{},
+		},
+		{
+			name:     "zero",
+			input:    []int{1, 2, 3},
+			n:        0,
+			expected: []int{},
+		},
+		{
+			name:     "negative",
+			input:    []int{1, 2, 3},
+			n:        -1,
+			expected: []int{},
+		},
+		{
+			name:     "fewer than length",
+			input:    []int{1, 2, 3},
+			n:        2,
+			expected: []int{1, 2},
+		},
+		{
+			name:     "equal to length",
+			input:    []int{1, 2, 3},
+			n:        3,
+			expected: []int{1, 2, 3},
+		},
+		{
+			name:     "more than length",
+			input:    []int{1, 2, 3},
+			n:        10,
+			expected: []int{1, 2, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, New(tt.input).CollectN(tt.n))
+		})
+	}
+
+	s.Run("returns a fresh slice", func() {
+		iter := New([]int{1, 2, 3})
+		result := iter.CollectN(2)
+		result[0] = 100
+		s.Equal([]int{1, 2, 3}, iter.Collect())
+	})
+}
+
+func (s *IterableSuite) TestBatch() {
+	s.Run("batch sizes", func() {
+		tests := []struct {
+			name     string
+			input    []int
+			size     int
+			expected [][]int
+		}{
+			{
+				name:     "empty slice",
+				input:    []int{},
+				size:     2,
+				expected: nil,
+			},
+			{
+				name:     "exact division",
+				input:    []int{1, 2, 3, 4},
+				size:     2,
+				expected: [][]int{{1, 2}, {3, 4}},
+			},
+			{
+				name:     "final short batch",
+				input:    []int{1, 2, 3, 4, 5},
+				size:     2,
+				expected: [][]int{{1, 2}, {3, 4}, {5}},
+			},
+		}
+
+		for _, tt := range tests {
+			s.Run(tt.name, func() {
+				var batches [][]int
+
+				err := New(tt.input).Batch(tt.size, func(batch []int) error {
+					batches = append(batches, batch)
+
+					return nil
+				})
+				s.Require().NoError(err)
+				s.Equal(tt.expected, batches)
+			})
+		}
+	})
+
+	s.Run("error propagation", func() {
+		errInsert := errors.New("insert failed")
+
+		calls := 0
+		err := New([]int{1, 2, 3, 4, 5}).Batch(2, func(batch []int) error {
+			calls++
+			if batch[0] == 3 {
+				return errInsert
+			}
+
+			return nil
+		})
+		s.Require().ErrorIs(err, errInsert)
+		s.Equal(2, calls)
+	})
+
+	s.Run("invalid size", func() {
+		called := false
+		fn := func([]int) error {
+			called = true
+
+			return nil
+		}
+
+		s.Require().ErrorIs(New([]int{1, 2}).Batch(0, fn), ErrInvalidBatchSize)
+		s.Require().ErrorIs(New([]int{1, 2}).Batch(-1, fn), ErrInvalidBatchSize)
+		s.False(called)
+	})
+
+	s.Run("appending to batch does not overwrite collection", func() {
+		iter := New([]int{1, 2, 3, 4})
+		err := iter.Batch(2, func(batch []int) error {
+			_ = append(batch, 0)
+
+			return nil
+		})
+		s.Require().NoError(err)
+		s.Equal([]int{1, 2, 3, 4}, iter.Collect())
+	})
+}
+
+func (s *IterableSuite) TestIntersperse() {
+	tests := []struct {
+		name     string
+		input    []string
+		expected []string
+	}{
+		{
+			name:     "empty slice",
+			input:    []string{},
+			expected: []string{},
+		},
+		{
+			name:     "single element",
+			input:    []string{"a"},
+			expected: []string{"a"},
+		},
+		{
+			name:     "two elements",
+			input:    []string{"a", "b"},
+			expected: []string{"a", "x", "b"},
+		},
+		{
+			name:     "multiple elements",
+			input:    []string{"a", "b", "c"},
+			expected: []string{"a", "x", "b", "x", "c"},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, New(tt.input).Intersperse("x").Collect())
+		})
+	}
+}
+
+func (s *IterableSuite) TestInterleave() {
+	tests := []struct {
+		name     string
+		a        []int
+		b        []int
+		expected []int
+	}{
+		{
+			name:     "both empty",
+			a:        []int{},
+			b:        []int{},
+			expected: []int{},
+		},
+		{
+			name:     "one empty",
+			a:        []int{},
+			b:        []int{1, 2},
+			expected: []int{1, 2},
+		},
+		{
+			name:     "equal lengths",
+			a:        []int{1, 3, 5},
+			b:        []int{2, 4, 6},
+			expected: []int{1, 2, 3, 4, 5, 6},
+		},
+		{
+			name:     "first longer",
+			a:        []int{1, 3, 5, 7},
+			b:        []int{2},
+			expected: []int{1, 2, 3, 5, 7},
+		},
+		{
+			name:     "second longer",
+			a:        []int{1},
+			b:        []int{2, 4, 6},
+			expected: []int{1, 2, 4, 6},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, Interleave(New(tt.a), New(tt.b)).Collect())
+		})
+	}
+}
+
+func (s *IterableSuite) TestCycle() {
+	tests := []struct {
+		name     string
+		input    []int
+		times    int
+		expected []int
+	}{
+		{
+			name:     "empty slice",
+			input:    []int{},
+			times:    3,
+			expected: []int{},
+		},
+		{
+			name:     "zero times",
+			input:    []int{1, 2},
+			times:    0,
+			expected: []int{},
+		},
+		{
+			name:     "negative times",
+			input:    []int{1, 2},
+			times:    -1,
+			expected: []int{},
+		},
+		{
+			name:     "once",
+			input:    []int{1, 2},
+			times:    1,
+			expected: []int{1, 2},
+		},
+		{
+			name:     "three times",
+			input:    []int{1, 2},
+			times:    3,
+			expected: []int{1, 2, 1, 2, 1, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, New(tt.input).Cycle(tt.times).Collect())
+		})
+	}
+}
+
+func (s *IterableSuite) TestPad() {
+	tests := []struct {
+		name          string
+		input         []int
+		length        int
+		expectedRight []int
+		expectedLeft  []int
+	}{
+		{
+			name:          "empty slice",
+			input:         []int{},
+			length:        2,
+			expectedRight: []int{0, 0},
+			expectedLeft:  []int{0, 0},
+		},
+		{
+			name:          "shorter than length",
+			input:         []int{1, 2},
+			length:        4,
+			expectedRight: []int{1, 2, 0, 0},
+			expectedLeft:  []int{0, 0, 1, 2},
+		},
+		{
+			name:          "equal to length",
+			input:         []int{1, 2, 3},
+			length:        3,
+			expectedRight: []int{1, 2, 3},
+			expectedLeft:  []int{1, 2, 3},
+		},
+		{
+			name:          "longer than length",
+			input:         []int{1, 2, 3},
+			length:        1,
+			expectedRight: []int{1, 2, 3},
+			expectedLeft:  []int{1, 2, 3},
+		},
+		{
+			name:          "negative length",
+			input:         []int{1},
+			length:        -1,
+			expectedRight: []int{1},
+			expectedLeft:  []int{1},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expectedRight, New(tt.input).PadRight(tt.length, 0).Collect())
+			s.Equal(tt.expectedLeft, New(tt.input).PadLeft(tt.length, 0).Collect())
+		})
+	}
+
+	s.Run("aligns rows", func() {
+		row := New([]string{"a", "b"}).PadRight(4, "-").Collect()
+		s.Equal([]string{"a", "b", "-", "-"}, row)
+	})
+}
+
+func (s *IterableSuite) TestRotate() {
+	tests := []struct {
+		name     string
+		input    []int
+		n        int
+		expected []int
+	}{
+		{
+			name:     "empty slice",
+			input:    []int{},
+			n:        3,
+			expected: []int{},
+		},
+		{
+			name:     "zero",
+			input:    []int{1, 2, 3, 4, 5},
+			n:        0,
+			expected: []int{1, 2, 3, 4, 5},
+		},
+		{
+			name:     "left",
+			input:    []int{1, 2, 3, 4, 5},
+			n:        2,
+			expected: []int{3, 4, 5, 1, 2},
+		},
+		{
+			name:     "right",
+			input:    []int{1, 2, 3, 4, 5},
+			n:        -2,
+			expected: []int{4, 5, 1, 2, 3},
+		},
+		{
+			name:     "multiple of length",
+			input:    []int{1, 2, 3, 4, 5},
+			n:        10,
+			expected: []int{1, 2, 3, 4, 5},
+		},
+		{
+			name:     "larger than length",
+			input:    []int{1, 2, 3, 4, 5},
+			n:        7,
+			expected: []int{3, 4, 5, 1, 2},
+		},
+		{
+			name:     "negative larger than length",
+			input:    []int{1, 2, 3, 4, 5},
+			n:        -6,
+			expected: []int{5, 1, 2, 3, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, New(tt.input).Rotate(tt.n).Collect())
+		})
+	}
+}
+
+func (s *IterableSuite) TestShuffle() {
+	s.Run("seeded source is deterministic", func() {
+		result := Range(0, 10, 1).Shuffle(rand.New(rand.NewPCG(1, 2))).Collect()
+		s.Equal([]int{4, 2, 3, 6, 9, 1, 8, 0, 5, 7}, result)
+	})
+
+	s.Run("elements are preserved", func() {
+		input := Range(0, 100, 1)
+		shuffled := input.Clone().Shuffle(rand.New(rand.NewPCG(3, 4)))
+		s.True(input.EqualUnordered(shuffled))
+		s.False(input.Equal(shuffled))
+	})
+
+	s.Run("default source", func() {
+		input := Range(0, 100, 1)
+		s.True(input.EqualUnordered(input.Clone().Shuffle(nil)))
+	})
+
+	s.Run("empty slice", func() {
+		s.Equal([]int{}, New([]int{}).Shuffle(nil).Collect())
+	})
+}
+
+func (s *IterableSuite) TestSample() {
+	s.Run("boundaries", func() {
+		tests := []struct {
+			name     string
+			k        int
+			expected []int
+		}{
+			{name: "zero", k: 0, expected: []int{}},
+			{name: "negative", k: -1, expected: []int{}},
+			{name: "equal to length", k: 5, expected: []int{1, 2, 3, 4, 5}},
+			{name: "greater than length", k: 10, expected: []int{1, 2, 3, 4, 5}},
+		}
+
+		for _, tt := range tests {
+			s.Run(tt.name, func() {
+				result := New([]int{1, 2, 3, 4, 5}).Sample(tt.k, nil).Collect()
+				s.Equal(tt.expected, result)
+			})
+		}
+	})
+
+	s.Run("seeded source is deterministic", func() {
+		first := Range(0, 100, 1).Sample(5, rand.New(rand.NewPCG(1, 2))).Collect()
+		second := Range(0, 100, 1).Sample(5, rand.New(rand.NewPCG(1, 2))).Collect()
+		s.Equal(first, second)
+		s.Equal([]int{38, 25, 48, 3, 42}, first)
+	})
+
+	s.Run("distinct elements from the source", func() {
+		source := Range(0, 1000, 1)
+		sample := source.Clone().Sample(50, nil)
+		s.Equal(50, sample.Len())
+		s.Equal(50, sample.Clone().Unique().Len())
+		s.True(sample.All(source.Contains))
+	})
+}
+
+func (s *IterableSuite) TestCompact() {
+	s.Run("integers", func() {
+		tests := []struct {
+			name     string
+			input    []int
+			expected []int
+		}{
+			{
+				name:     "empty slice",
+				input:    []int{},
+				expected: []int{},
+			},
+			{
+				name:     "all zero",
+				input:    []int{0, 0, 0},
+				expected: []int{},
+			},
+			{
+				name:     "no zero",
+				input:    []int{1, 2, 3},
+				expected: []int{1, 2, 3},
+			},
+			{
+				name:     "mixed",
+				input:    []int{0, 1, 0, 2},
+				expected: []int{1, 2},
+			},
+		}
+
+		for _, tt := range tests {
+			s.Run(tt.name, func() {
+				s.Equal(tt.expected, New(tt.input).Compact().Collect())
+			})
+		}
+	})
+
+	s.Run("strings", func() {
+		s.Equal([]string{}, New([]string{"", ""}).Compact().Collect())
+		s.Equal([]string{"a", "b"}, New([]string{"a", "b"}).Compact().Collect())
+		s.Equal([]string{"a", "b"}, New([]string{"", "a", "", "b", ""}).Compact().Collect())
+	})
+
+	s.Run("pointers", func() {
+		one, two := 1, 2
+		result := New([]*int{nil, &one, nil, &two}).Compact().Collect()
+		s.Equal([]*int{&one, &two}, result)
+	})
+}
+
+func (s *IterableSuite) TestCountBy() {
+	s.Run("by derived key", func() {
+		result := CountBy(New([]string{"go", "rust", "zig", "c", "java"}), func(s string) int {
+			return len(s)
+		})
+		s.Equal(map[int]int{1: 1, 2: 1, 3: 1, 4: 2}, result)
+	})
+
+	s.Run("empty slice", func() {
+		s.Equal(map[bool]int{}, CountBy(New([]int{}), func(i int) bool { return i > 0 }))
+		s.Equal(map[int]int{}, New([]int{}).Frequencies())
+	})
+
+	s.Run("word frequencies", func() {
+		words := strings.Fields("the quick fox jumps over the lazy dog the end")
+		s.Equal(map[string]int{
+			"the":   3,
+			"quick": 1,
+			"fox":   1,
+			"jumps": 1,
+			"over":  1,
+			"lazy":  1,
+			"dog":   1,
+			"end":   1,
+		}, New(words).Frequencies())
+	})
+}
+
+func (s *IterableSuite) TestReduceMap() {
+	type sale struct {
+		region string
+		amount int
+	}
+
+	sumByRegion := func(totals map[string]int, item sale) {
+		totals[item.region] += item.amount
+	}
+
+	tests := []struct {
+		name     string
+		input    []sale
+		expected map[string]int
+	}{
+		{
+			name:     "empty slice",
+			input:    []sale{},
+			expected: map[string]int{},
+		},
+		{
+			name: "keyed sums",
+			input: []sale{
+				{region: "east", amount: 10},
+				{region: "west", amount: 5},
+				{region: "east", amount: 7},
+			},
+			expected: map[string]int{"east": 17, "west": 5},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, ReduceMap(New(tt.input), sumByRegion))
+		})
+	}
+
+	s.Run("grouping with transformation", func() {
+		result := ReduceMap(New([]string{"apple", "avocado", "banana"}),
+			func(groups map[byte][]string, item string) {
+				groups[item[0]] = append(groups[item[0]], strings.ToUpper(item))
+			})
+		s.Equal(map[byte][]string{
+			'a': {"APPLE", "AVOCADO"},
+			'b': {"BANANA"},
+		}, result)
+	})
+}
+
+func (s *IterableSuite) TestMinMaxSinglePass() {
+	tests := []struct {
+		name     string
+		input    []int
+		smallest int
+		largest  int
+		ok       bool
+	}{
+		{
+			name:     "empty slice",
+			input:    []int{},
+			smallest: 0,
+			largest:  0,
+			ok:       false,
+		},
+		{
+			name:     "single element",
+			input:    []int{7},
+			smallest: 7,
+			largest:  7,
+			ok:       true,
+		},
+		{
+			name:     "ties",
+			input:    []int{3, 1, 3, 1},
+			smallest: 1,
+			largest:  3,
+			ok:       true,
+		},
+		{
+			name:     "negative values",
+			input:    []int{-5, 10, 0, -20, 15},
+			smallest: -20,
+			largest:  15,
+			ok:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			smallest, largest, ok := MinMax(New(tt.input))
+			s.Equal(tt.smallest, smallest)
+			s.Equal(tt.largest, largest)
+			s.Equal(tt.ok, ok)
+		})
+	}
+
+	s.Run("matches min and max", func() {
+		iter := New([]string{"pear", "apple", "zucchini", "fig"})
+
+		smallest, largest, ok := MinMax(iter)
+		s.True(ok)
+
+		expectedMin, _ := Min(iter)
+		expectedMax, _ := Max(iter)
+		s.Equal(expectedMin, smallest)
+		s.Equal(expectedMax, largest)
+	})
+}
+
+func (s *IterableSuite) TestTopN() {
+	tests := []struct {
+		name           string
+		input          []int
+		n              int
+		expectedTop    []int
+		expectedBottom []int
+	}{
+		{
+			name:           "empty slice",
+			input:          []int{},
+			n:              3,
+			expectedTop:    []int{},
+			expectedBottom: []int{},
+		},
+		{
+			name:           "zero",
+			input:          []int{3, 1, 2},
+			n:              0,
+			expectedTop:    []int{},
+			expectedBottom: []int{},
+		},
+		{
+			name:           "negative",
+			input:          []int{3, 1, 2},
+			n:              -1,
+			expectedTop:    []int{},
+			expectedBottom: []int{},
+		},
+		{
+			name:           "fewer than length",
+			input:          []int{5, 1, 9, 3, 7, 2},
+			n:              3,
+			expectedTop:    []int{9, 7, 5},
+			expectedBottom: []int{1, 2, 3},
+		},
+		{
+			name:           "more than length",
+			input:          []int{2, 3, 1},
+			n:              10,
+			expectedTop:    []int{3, 2, 1},
+			expectedBottom: []int{1, 2, 3},
+		},
+		{
+			name:           "ties",
+			input:          []int{4, 8, 1, 8, 4, 1, 8},
+			n:              4,
+			expectedTop:    []int{8, 8, 8, 4},
+			expectedBottom: []int{1, 1, 4, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expectedTop, TopN(New(tt.input), tt.n).Collect())
+			s.Equal(tt.expectedBottom, BottomN(New(tt.input), tt.n).Collect())
+		})
+	}
+
+	s.Run("collection is not modified", func() {
+		iter := New([]int{5, 1, 9, 3})
+		TopN(iter, 2)
+		BottomN(iter, 2)
+		s.Equal([]int{5, 1, 9, 3}, iter.Collect())
+	})
+
+	s.Run("matches a full sort", func() {
+		r := rand.New(rand.NewPCG(1, 2))
+		input := Generate(200, func(int) int { return r.IntN(50) }).Collect()
+		sorted := slices.Sorted(slices.Values(input))
+
+		s.Equal(sorted[:20], BottomN(New(input), 20).Collect())
+
+		slices.Reverse(sorted)
+		s.Equal(sorted[:20], TopN(New(input), 20).Collect())
+	})
+}
+
+func (s *IterableSuite) TestReduceWhile() {
+	s.Run("empty slice", func() {
+		result := ReduceWhile(New([]int{}), 42, func(acc, item int) (int, bool) {
+			return acc + item, true
+		})
+		s.Equal(42, result)
+	})
+
+	s.Run("runs to completion", func() {
+		result := ReduceWhile(New([]int{1, 2, 3}), 0, func(acc, item int) (int, bool) {
+			return acc + item, true
+		})
+		s.Equal(6, result)
+	})
+
+	s.Run("stops before the end", func() {
+		var visited []int
+
+		result := ReduceWhile(New([]int{4, 3, 5, 2, 6}), 0, func(acc, item int) (int, bool) {
+			visited = append(visited, item)
+			acc += item
+
+			return acc, acc < 10
+		})
+		s.Equal(12, result)
+		s.Equal([]int{4, 3, 5}, visited, "remaining elements should not be visited")
+	})
+
+	s.Run("accumulate until size threshold", func() {
+		words := New([]string{"alpha", "beta", "gamma", "delta"})
+		result := ReduceWhile(words, 0, func(count int, word string) (int, bool) {
+			if count+len(word) > 10 {
+				return count, false
+			}
+
+			return count + len(word), true
+		})
+		s.Equal(9, result)
+	})
+}
+
+func (s *IterableSuite) TestChunkWhile() {
+	tests := []struct {
+		name      string
+		input     []int
+		sameGroup func(prev, curr int) bool
+		expected  [][]int
+	}{
+		{
+			name:      "empty slice",
+			input:     []int{},
+			sameGroup: func(int, int) bool { return true },
+			expected:  [][]int{},
+		},
+		{
+			name:      "single element",
+			input:     []int{1},
+			sameGroup: func(int, int) bool { return false },
+			expected:  [][]int{{1}},
+		},
+		{
+			name:      "always true",
+			input:     []int{1, 2, 3},
+			sameGroup: func(int, int) bool { return true },
+			expected:  [][]int{{1, 2, 3}},
+		},
+		{
+			name:      "always false",
+			input:     []int{1, 2, 3},
+			sameGroup: func(int, int) bool { return false },
+			expected:  [][]int{{1}, {2}, {3}},
+		},
+		{
+			name:      "ascending runs",
+			input:     []int{1, 2, 4, 3, 5, 6, 0},
+			sameGroup: func(prev, curr int) bool { return curr > prev },
+			expected:  [][]int{{1, 2, 4}, {3, 5, 6}, {0}},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, ChunkWhile(New(tt.input), tt.sameGroup))
+		})
+	}
+}
+
+func (s *IterableSuite) TestChunkBy() {
+	tens := func(item int) int { return item / 10 }
+
+	tests := []struct {
+		name     string
+		input    []int
+		expected [][]int
+	}{
+		{
+			name:     "empty slice",
+			input:    []int{},
+			expected: [][]int{},
+		},
+		{
+			name:     "consecutive elements share keys",
+			input:    []int{1, 5, 12, 17, 19, 3, 4},
+			expected: [][]int{{1, 5}, {12, 17, 19}, {3, 4}},
+		},
+		{
+			name:     "every element has a distinct key",
+			input:    []int{5, 15, 25},
+			expected: [][]int{{5}, {15}, {25}},
+		},
+		{
+			name:     "single key",
+			input:    []int{1, 2, 3},
+			expected: [][]int{{1, 2, 3}},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, ChunkBy(New(tt.input), tens))
+		})
+	}
+
+	s.Run("chunks are copies", func() {
+		iter := New([]int{1, 2, 11})
+		chunks := ChunkBy(iter, tens)
+		chunks[0][0] = 100
+		s.Equal([]int{1, 2, 11}, iter.Collect())
+	})
+}
+
+func (s *IterableSuite) TestStats() {
+	s.Run("empty slice", func() {
+		result, ok := Stats(New([]int{}))
+		s.False(ok)
+		s.Equal(StatsResult[int]{}, result)
+	})
+
+	s.Run("matches manual computation", func() {
+		input := []int{4, -2, 9, 7, 2}
+
+		result, ok := Stats(New(input))
+		s.Require().True(ok)
+		s.Equal(5, result.Count)
+		s.Equal(20, result.Sum)
+		s.Equal(-2, result.Min)
+		s.Equal(9, result.Max)
+		s.InDelta(4.0, result.Mean, 1e-9)
+	})
+
+	s.Run("matches individual reductions", func() {
+		iter := New([]float64{1.5, 0.25, 3.75, 2.5})
+
+		result, ok := Stats(iter)
+		s.Require().True(ok)
+
+		smallest, largest, _ := MinMax(iter)
+		average, _ := Average(iter)
+		s.Equal(iter.Len(), result.Count)
+		s.InDelta(Sum(iter), result.Sum, 1e-9)
+		s.InDelta(smallest, result.Min, 1e-9)
+		s.InDelta(largest, result.Max, 1e-9)
+		s.InDelta(average, result.Mean, 1e-9)
+	})
+
+	s.Run("narrow integers", func() {
+		result, ok := Stats(New([]uint8{200, 100}))
+		s.Require().True(ok)
+		s.Equal(2, result.Count)
+		s.Equal(uint8(100), result.Min)
+		s.Equal(uint8(200), result.Max)
+		s.InDelta(150.0, result.Mean, 1e-9)
+	})
+}
+
+func (s *IterableSuite) TestMovingAverage() {
+	tests := []struct {
+		name     string
+		input    []int
+		window   int
+		expected []float64
+	}{
+		{
+			name:     "empty slice",
+			input:    []int{},
+			window:   2,
+			expected: []float64{},
+		},
+		{
+			name:     "window of one",
+			input:    []int{1, 2, 3},
+			window:   1,
+			expected: []float64{1, 2, 3},
+		},
+		{
+			name:     "window of two",
+			input:    []int{1, 2, 3, 4},
+			window:   2,
+			expected: []float64{1.5, 2.5, 3.5},
+		},
+		{
+			name:     "window equal to length",
+			input:    []int{1, 2, 3, 6},
+			window:   4,
+			expected: []float64{3},
+		},
+		{
+			name:     "window greater than length",
+			input:    []int{1, 2, 3},
+			window:   4,
+			expected: []float64{},
+		},
+		{
+			name:     "non-positive window",
+			input:    []int{1, 2, 3},
+			window:   0,
+			expected: []float64{},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			result := MovingAverage(New(tt.input), tt.window).Collect()
+			s.InDeltaSlice(tt.expected, result, 1e-9)
+			s.Len(result, len(tt.expected))
+		})
+	}
+}
+
+func (s *IterableSuite) TestHistogram() {
+	tests := []struct {
+		name     string
+		input    []int
+		bins     int
+		lower    int
+		upper    int
+		expected []int
+	}{
+		{
+			name:     "empty slice",
+			input:    []int{},
+			bins:     3,
+			lower:    0,
+			upper:    3,
+			expected: []int{0, 0, 0},
+		},
+		{
+			name:     "evenly distributed",
+			input:    []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+			bins:     5,
+			lower:    0,
+			upper:    10,
+			expected: []int{2, 2, 2, 2, 2},
+		},
+		{
+			name:     "values on bin boundaries",
+			input:    []int{0, 2, 4, 6, 8, 10},
+			bins:     5,
+			lower:    0,
+			upper:    10,
+			expected: []int{1, 1, 1, 1, 2},
+		},
+		{
+			name:     "out of range values are clamped",
+			input:    []int{-100, -1, 5, 11, 1000},
+			bins:     3,
+			lower:    0,
+			upper:    9,
+			expected: []int{2, 1, 2},
+		},
+		{
+			name:     "single bin",
+			input:    []int{1, 2, 3},
+			bins:     1,
+			lower:    0,
+			upper:    1,
+			expected: []int{3},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, Histogram(New(tt.input), tt.bins, tt.lower, tt.upper))
+		})
+	}
+
+	s.Run("floating point", func() {
+		input := []float64{0.1, 0.25, 0.5, 0.75, 0.99}
+		s.Equal([]int{1, 1, 1, 2}, Histogram(New(input), 4, 0, 1))
+	})
+
+	s.Run("floating point values on bin boundaries", func() {
+		input := []float64{0, 0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9, 1}
+		s.Equal([]int{1, 1, 1, 1, 1, 1, 1, 1, 1, 2}, Histogram(New(input), 10, 0, 1))
+
+		for item, bucket := range map[float64]int{0.3: 3, 0.6: 6, 0.7: 7} {
+			counts := Histogram(New([]float64{item}), 10, 0, 1)
+			s.Equal(1, counts[bucket], "value %v", item)
+		}
+	})
+
+	s.Run("invalid arguments", func() {
+		s.Panics(func() { Histogram(New([]int{1}), 0, 0, 10) })
+		s.Panics(func() { Histogram(New([]int{1}), -1, 0, 10) })
+		s.Panics(func() { Histogram(New([]int{1}), 2, 10, 10) })
+		s.Panics(func() { Histogram(New([]int{1}), 2, 10, 0) })
+	})
+}
+
+func (s *IterableSuite) TestProduct() {
+	s.Run("row-major order", func() {
+		result := Product(New([]string{"a", "b"}), New([]int{1, 2, 3})).Collect()
+		s.Equal([]Pair[string, int]{
+			{First: "a", Second: 1},
+			{First: "a", Second: 2},
+			{First: "a", Second: 3},
+			{First: "b", Second: 1},
+			{First: "b", Second: 2},
+			{First: "b", Second: 3},
+		}, result)
+	})
+
+	s.Run("count", func() {
+		tests := []struct {
+			name string
+			a    []int
+			b    []int
+		}{
+			{name: "both empty", a: []int{}, b: []int{}},
+			{name: "first empty", a: []int{}, b: []int{1, 2}},
+			{name: "second empty", a: []int{1, 2}, b: []int{}},
+			{name: "square", a: []int{1, 2, 3}, b: []int{4, 5, 6}},
+			{name: "rectangle", a: []int{1, 2, 3, 4}, b: []int{5, 6}},
+		}
+
+		for _, tt := range tests {
+			s.Run(tt.name, func() {
+				s.Equal(len(tt.a)*len(tt.b), Product(New(tt.a), New(tt.b)).Len())
+			})
+		}
+	})
+}
+
+func (s *IterableSuite) TestCombinations() {
+	tests := []struct {
+		name     string
+		input    []int
+		k        int
+		expected [][]int
+	}{
+		{
+			name:     "choose two of four",
+			input:    []int{1, 2, 3, 4},
+			k:        2,
+			expected: [][]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}},
+		},
+		{
+			name:     "choose zero",
+			input:    []int{1, 2, 3},
+			k:        0,
+			expected: [][]int{{}},
+		},
+		{
+			name:     "choose all",
+			input:    []int{1, 2, 3},
+			k:        3,
+			expected: [][]int{{1, 2, 3}},
+		},
+		{
+			name:     "choose one",
+			input:    []int{1, 2, 3},
+			k:        1,
+			expected: [][]int{{1}, {2}, {3}},
+		},
+		{
+			name:     "more than length",
+			input:    []int{1, 2, 3},
+			k:        4,
+			expected: [][]int{},
+		},
+		{
+			name:     "negative",
+			input:    []int{1, 2, 3},
+			k:        -1,
+			expected: [][]int{},
+		},
+		{
+			name:     "empty slice choose zero",
+			input:    []int{},
+			k:        0,
+			expected: [][]int{{}},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, Combinations(New(tt.input), tt.k))
+		})
+	}
+
+	s.Run("binomial count", func() {
+		s.Len(Combinations(Range(0, 10, 1), 3), 120)
+	})
+}
+
+func (s *IterableSuite) TestPermutations() {
+	s.Run("three elements", func() {
+		s.Equal([][]string{
+			{"a", "b", "c"},
+			{"a", "c", "b"},
+			{"b", "a", "c"},
+			{"b", "c", "a"},
+			{"c", "a", "b"},
+			{"c", "b", "a"},
+		}, Permutations(New([]string{"a", "b", "c"})))
+	})
+
+	s.Run("empty slice", func() {
+		s.Equal([][]int{{}}, Permutations(New([]int{})))
+	})
+
+	s.Run("single element", func() {
+		s.Equal([][]int{{1}}, Permutations(New([]int{1})))
+	})
+
+	s.Run("factorial count", func() {
+		s.Len(Permutations(Range(0, 5, 1)), 120)
+	})
+
+	s.Run("duplicates are permuted by position", func() {
+		s.Equal([][]int{{1, 1}, {1, 1}}, Permutations(New([]int{1, 1})))
+	})
+}
+
+func (s *IterableSuite) TestMapConstructors() {
+	m := map[string]int{"a": 1, "b": 2, "c": 3, "d": 2}
+
+	s.Run("keys", func() {
+		s.ElementsMatch([]string{"a", "b", "c", "d"}, Keys(m).Collect())
+	})
+
+	s.Run("sorted keys", func() {
+		s.Equal([]string{"a", "b", "c", "d"}, SortedKeys(m).Collect())
+	})
+
+	s.Run("values", func() {
+		s.ElementsMatch([]int{1, 2, 2, 3}, Values(m).Collect())
+	})
+
+	s.Run("empty map", func() {
+		s.True(Keys(map[string]int{}).IsEmpty())
+		s.True(SortedKeys(map[string]int{}).IsEmpty())
+		s.True(Values(map[string]int{}).IsEmpty())
+
+		s.Equal([]string{}, Keys(map[string]int{}).Collect())
+		s.Equal([]string{}, SortedKeys(map[string]int{}).Collect())
+		s.Equal([]int{}, Values(map[string]int{}).Collect())
+	})
+
+	s.Run("keys with non-comparable values", func() {
+		keys := SortedKeys(map[int][]string{2: {"b"}, 1: {"a"}})
+		s.Equal([]int{1, 2}, keys.Collect())
+	})
+}
+
+func (s *IterableSuite) TestSeqReversed() {
+	s.Run("reverse order", func() {
+		var visited []int
+		for item := range New([]int{1, 2, 3}).SeqReversed() {
+			visited = append(visited, item)
+		}
+
+		s.Equal([]int{3, 2, 1}, visited)
+	})
+
+	s.Run("empty slice", func() {
+		s.Empty(slices.Collect(New([]int{}).SeqReversed()))
+	})
+
+	s.Run("early termination", func() {
+		var visited []int
+		for item := range New([]int{1, 2, 3, 4}).SeqReversed() {
+			if item == 2 {
+				break
+			}
+
+			visited = append(visited, item)
+		}
+
+		s.Equal([]int{4, 3}, visited)
+	})
+
+	s.Run("does not modify collection", func() {
+		iter := New([]int{1, 2, 3})
+		for range iter.SeqReversed() {
+			continue
+		}
+
+		s.Equal([]int{1, 2, 3}, iter.Collect())
+	})
+}
+
+func (s *IterableSuite) TestRunLengthEncode() {
+	tests := []struct {
+		name     string
+		input    []string
+		expected []Pair[string, int]
+	}{
+		{
+			name:     "empty slice",
+			input:    []string{},
+			expected: []Pair[string, int]{},
+		},
+		{
+			name:     "single run",
+			input:    []string{"a", "a", "a"},
+			expected: []Pair[string, int]{{First: "a", Second: 3}},
+		},
+		{
+			name:  "runs",
+			input: []string{"a", "a", "b"},
+			expected: []Pair[string, int]{
+				{First: "a", Second: 2},
+				{First: "b", Second: 1},
+			},
+		},
+		{
+			name:  "alternating values",
+			input: []string{"a", "b", "a", "b"},
+			expected: []Pair[string, int]{
+				{First: "a", Second: 1},
+				{First: "b", Second: 1},
+				{First: "a", Second: 1},
+				{First: "b", Second: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, RunLengthEncode(New(tt.input)).Collect())
+		})
+	}
+}
+
+func (s *IterableSuite) TestFilterMap() {
+	parse := func(s string) (int, bool) {
+		value, err := strconv.Atoi(s)
+
+		return value, err == nil
+	}
+
+	tests := []struct {
+		name     string
+		input    []string
+		expected []int
+	}{
+		{
+			name:     "empty slice",
+			input:    []string{},
+			expected: []int{},
+		},
+		{
+			name:     "some kept",
+			input:    []string{"1", "x", "3", "", "5"},
+			expected: []int{1, 3, 5},
+		},
+		{
+			name:     "none kept",
+			input:    []string{"a", "b"},
+			expected: []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, FilterMap(New(tt.input), parse).Collect())
+		})
+	}
+
+	s.Run("single traversal", func() {
+		calls := 0
+		result := FilterMap(New([]int{1, 2, 3, 4, 5}), func(i int) (string, bool) {
+			calls++
+
+			return strconv.Itoa(i * i), i%2 == 1
+		}).Collect()
+		s.Equal([]string{"1", "9", "25"}, result)
+		s.Equal(5, calls)
+	})
+}
@@ -0,0 +1,27 @@
+package iterable
+
+import "encoding/json"
+
+// MarshalJSON encodes the collection as a JSON array. An empty or nil collection
+// is encoded as [] rather than null. It has a value receiver so that Iterable
+// fields embedded by value in a struct are encoded too.
+func (i Iterable[T]) MarshalJSON() ([]byte, error) {
+	if i.collection == nil {
+		return json.Marshal([]T{})
+	}
+
+	return json.Marshal(i.collection)
+}
+
+// UnmarshalJSON decodes a JSON array into the collection, replacing any elements
+// it held before.
+func (i *Iterable[T]) UnmarshalJSON(data []byte) error {
+	var collection []T
+	if err := json.Unmarshal(data, &collection); err != nil {
+		return err
+	}
+
+	i.collection = collection
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package iterable
+
+import "encoding/json"
+
+func (s *IterableSuite) TestMarshalJSON() {
+	tests := []struct {
+		name     string
+		iter     json.Marshaler
+		expected string
+	}{
+		{
+			name:     "empty slice",
+			iter:     New([]int{}),
+			expected: `[]`,
+		},
+		{
+			name:     "nil slice",
+			iter:     New[int](nil),
+			expected: `[]`,
+		},
+		{
+			name:     "integers",
+			iter:     New([]int{1, 2, 3}),
+			expected: `[1,2,3]`,
+		},
+		{
+			name:     "strings",
+			iter:     New([]string{"a", "b"}),
+			expected: `["a","b"]`,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			data, err := json.Marshal(tt.iter)
+			s.Require().NoError(err)
+			s.JSONEq(tt.expected, string(data))
+		})
+	}
+}
+
+func (s *IterableSuite) TestUnmarshalJSON() {
+	s.Run("round trip", func() {
+		original := New([]string{"a", "b", "c"})
+
+		data, err := json.Marshal(original)
+		s.Require().NoError(err)
+
+		var decoded Iterable[string]
+		s.Require().NoError(json.Unmarshal(data, &decoded))
+		s.True(original.Equal(&decoded))
+	})
+
+	s.Run("struct field", func() {
+		type document struct {
+			Tags *Iterable[string] `json:"tags"`
+		}
+
+		var doc document
+		s.Require().NoError(json.Unmarshal([]byte(`{"tags":["go","json"]}`), &doc))
+		s.Equal([]string{"go", "json"}, doc.Tags.Collect())
+
+		data, err := json.Marshal(document{Tags: New([]string{})})
+		s.Require().NoError(err)
+		s.JSONEq(`{"tags":[]}`, string(data))
+	})
+
+	s.Run("value struct field", func() {
+		type document struct {
+			Tags Iterable[string] `json:"tags"`
+		}
+
+		data, err := json.Marshal(document{Tags: *New([]string{"a"})})
+		s.Require().NoError(err)
+		s.JSONEq(`{"tags":["a"]}`, string(data))
+
+		var doc document
+		s.Require().NoError(json.Unmarshal(data, &doc))
+		s.Equal([]string{"a"}, doc.Tags.Collect())
+	})
+
+	s.Run("replaces existing elements", func() {
+		iter := New([]int{9, 9})
+		s.Require().NoError(json.Unmarshal([]byte(`[1,2]`), iter))
+		s.Equal([]int{1, 2}, iter.Collect())
+	})
+
+	s.Run("invalid input", func() {
+		var iter Iterable[int]
+		s.Require().Error(json.Unmarshal([]byte(`{"not":"an array"}`), &iter))
+		s.Require().Error(json.Unmarshal([]byte(`["a"]`), &iter))
+	})
+}
@@ -0,0 +1,99 @@
+package iterable
+
+import (
+	"iter"
+	"slices"
+)
+
+// Lazy represents a deferred pipeline over the elements of an Iterable. Stages added
+// with Filter, Mutate, Take and LazyMap are composed into a single pass that only runs
+// when the pipeline is consumed, so no intermediate slices are allocated and stages
+// such as Take stop pulling elements from earlier stages once they are satisfied.
+type Lazy[T comparable] struct {
+	seq iter.Seq[T]
+}
+
+// Lazy returns a deferred pipeline over the elements of the collection.
+// Operations on the returned Lazy do not run until Collect or Seq is consumed.
+func (i *Iterable[T]) Lazy() *Lazy[T] {
+	return &Lazy[T]{seq: i.Seq()}
+}
+
+// Filter adds a stage that only passes on elements satisfying the predicate function.
+// Returns the same Lazy instance to enable method chaining.
+func (l *Lazy[T]) Filter(predicate func(item T) bool) *Lazy[T] {
+	seq := l.seq
+	l.seq = func(yield func(T) bool) {
+		for item := range seq {
+			if predicate(item) && !yield(item) {
+				return
+			}
+		}
+	}
+
+	return l
+}
+
+// Mutate adds a stage that applies the mutation function to each element before
+// passing it on. Returns the same Lazy instance to enable method chaining.
+func (l *Lazy[T]) Mutate(mutate func(item *T)) *Lazy[T] {
+	seq := l.seq
+	l.seq = func(yield func(T) bool) {
+		for item := range seq {
+			mutate(&item)
+
+			if !yield(item) {
+				return
+			}
+		}
+	}
+
+	return l
+}
+
+// Take adds a stage that passes on at most n elements and then stops pulling from
+// earlier stages. A negative n is treated as 0.
+// Returns the same Lazy instance to enable method chaining.
+func (l *Lazy[T]) Take(n int) *Lazy[T] {
+	seq := l.seq
+	l.seq = func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+
+		taken := 0
+		for item := range seq {
+			taken++
+
+			if !yield(item) || taken >= n {
+				return
+			}
+		}
+	}
+
+	return l
+}
+
+// Seq returns an iterator that runs the pipeline as it is consumed.
+func (l *Lazy[T]) Seq() iter.Seq[T] {
+	return l.seq
+}
+
+// Collect runs the pipeline in a single pass and returns the resulting elements.
+func (l *Lazy[T]) Collect() []T {
+	return slices.AppendSeq([]T{}, l.seq)
+}
+
+// LazyMap adds a stage to a Lazy pipeline that transforms each element using the
+// provided mapper function, producing a pipeline of elements of type U.
+func LazyMap[T comparable, U comparable](l *Lazy[T], mapper func(item T) U) *Lazy[U] {
+	seq := l.seq
+
+	return &Lazy[U]{seq: func(yield func(U) bool) {
+		for item := range seq {
+			if !yield(mapper(item)) {
+				return
+			}
+		}
+	}}
+}
@@ -0,0 +1,89 @@
+package iterable
+
+import "strconv"
+
+func (s *IterableSuite) TestLazy() {
+	s.Run("single pass short circuit", func() {
+		input := make([]int, 1_000_000)
+		for i := range input {
+			input[i] = i
+		}
+
+		calls := 0
+		result := New(input).
+			Lazy().
+			Filter(func(i int) bool {
+				calls++
+
+				return i%2 == 0
+			}).
+			Take(10).
+			Collect()
+
+		s.Equal([]int{0, 2, 4, 6, 8, 10, 12, 14, 16, 18}, result)
+		s.Equal(19, calls, "filter should stop once take is satisfied")
+	})
+
+	s.Run("deferred until consumed", func() {
+		calls := 0
+		lazy := New([]int{1, 2, 3}).Lazy().Filter(func(int) bool {
+			calls++
+
+			return true
+		})
+		s.Zero(calls)
+
+		lazy.Collect()
+		s.Equal(3, calls)
+	})
+
+	s.Run("matches eager pipeline", func() {
+		input := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+		isEven := func(i int) bool { return i%2 == 0 }
+		double := func(i *int) { *i *= 2 }
+
+		eager := Map(New(input).Filter(isEven).Mutate(double), strconv.Itoa).Collect()
+		lazy := LazyMap(New(input).Lazy().Filter(isEven).Mutate(double), strconv.Itoa).Collect()
+		s.Equal(eager, lazy)
+	})
+
+	s.Run("does not modify source", func() {
+		iter := New([]int{1, 2, 3})
+		iter.Lazy().Mutate(func(i *int) { *i = 0 }).Collect()
+		s.Equal([]int{1, 2, 3}, iter.Collect())
+	})
+
+	s.Run("take boundaries", func() {
+		tests := []struct {
+			name     string
+			n        int
+			expected []int
+		}{
+			{name: "negative", n: -1, expected: []int{}},
+			{name: "zero", n: 0, expected: []int{}},
+			{name: "within bounds", n: 2, expected: []int{1, 2}},
+			{name: "greater than length", n: 10, expected: []int{1, 2, 3}},
+		}
+
+		for _, tt := range tests {
+			s.Run(tt.name, func() {
+				s.Equal(tt.expected, New([]int{1, 2, 3}).Lazy().Take(tt.n).Collect())
+			})
+		}
+	})
+
+	s.Run("take zero pulls nothing", func() {
+		calls := 0
+		New([]int{1, 2, 3}).Lazy().Mutate(func(*int) { calls++ }).Take(0).Collect()
+		s.Zero(calls)
+	})
+
+	s.Run("range over seq", func() {
+		var visited []string
+		for item := range LazyMap(New([]int{1, 2, 3}).Lazy(), strconv.Itoa).Seq() {
+			visited = append(visited, item)
+		}
+
+		s.Equal([]string{"1", "2", "3"}, visited)
+	})
+}
@@ -0,0 +1,71 @@
+package iterable
+
+import (
+	"runtime"
+	"sync"
+)
+
+// MapParallel creates a new Iterable by transforming each element in the source
+// Iterable using the provided mapper function, spreading the calls across a pool of
+// workers goroutines. The mapper is called exactly once per element and the output
+// keeps the order of the input. If workers is not positive, runtime.NumCPU() is used.
+func MapParallel[T comparable, U comparable](
+	iter *Iterable[T],
+	mapper func(item T) U,
+	workers int,
+) *Iterable[U] {
+	items := iter.Collect()
+	mapped := make([]U, len(items))
+
+	parallelFor(len(items), workers, func(idx int) {
+		mapped[idx] = mapper(items[idx])
+	})
+
+	return Wrap(mapped)
+}
+
+// ForEachParallel calls fn for each element in the collection using a pool of workers
+// goroutines and waits for all calls to finish before returning. Every element is
+// processed exactly once, but the order of the calls is not guaranteed. If workers
+// is not positive, runtime.NumCPU() is used. Returns the same Iterable instance to
+// enable method chaining.
+func (i *Iterable[T]) ForEachParallel(fn func(item T), workers int) *Iterable[T] {
+	parallelFor(len(i.collection), workers, func(idx int) {
+		fn(i.collection[idx])
+	})
+
+	return i
+}
+
+// parallelFor calls fn once for every index in [0, n) using a pool of workers
+// goroutines and returns once all calls have completed. If workers is not positive,
+// runtime.NumCPU() is used.
+func parallelFor(n, workers int, fn func(idx int)) {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	workers = min(workers, n)
+	indices := make(chan int)
+
+	var wg sync.WaitGroup
+
+	wg.Add(workers)
+
+	for range workers {
+		go func() {
+			defer wg.Done()
+
+			for idx := range indices {
+				fn(idx)
+			}
+		}()
+	}
+
+	for idx := range n {
+		indices <- idx
+	}
+
+	close(indices)
+	wg.Wait()
+}
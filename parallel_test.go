@@ -0,0 +1,89 @@
+package iterable
+
+import (
+	"strconv"
+	"sync/atomic"
+)
+
+func (s *IterableSuite) TestMapParallel() {
+	s.Run("matches serial map", func() {
+		input := make([]int, 1000)
+		for i := range input {
+			input[i] = i
+		}
+
+		tests := []struct {
+			name    string
+			workers int
+		}{
+			{name: "default workers", workers: 0},
+			{name: "negative workers", workers: -1},
+			{name: "single worker", workers: 1},
+			{name: "many workers", workers: 8},
+			{name: "more workers than elements", workers: 2000},
+		}
+
+		expected := Map(New(input), strconv.Itoa).Collect()
+
+		for _, tt := range tests {
+			s.Run(tt.name, func() {
+				result := MapParallel(New(input), strconv.Itoa, tt.workers).Collect()
+				s.Equal(expected, result)
+			})
+		}
+	})
+
+	s.Run("each element processed once", func() {
+		var calls atomic.Int64
+
+		result := MapParallel(New([]int{1, 2, 3, 4, 5}), func(i int) int {
+			calls.Add(1)
+
+			return i * i
+		}, 3).Collect()
+
+		s.Equal([]int{1, 4, 9, 16, 25}, result)
+		s.Equal(int64(5), calls.Load())
+	})
+
+	s.Run("empty slice", func() {
+		s.Equal([]string{}, MapParallel(New([]int{}), strconv.Itoa, 4).Collect())
+	})
+}
+
+func (s *IterableSuite) TestForEachParallel() {
+	s.Run("each element processed once", func() {
+		input := make([]int, 1000)
+		for i := range input {
+			input[i] = i + 1
+		}
+
+		var (
+			calls atomic.Int64
+			sum   atomic.Int64
+		)
+
+		result := New(input).ForEachParallel(func(i int) {
+			calls.Add(1)
+			sum.Add(int64(i))
+		}, 4).Collect()
+
+		s.Equal(int64(len(input)), calls.Load())
+		s.Equal(int64(500500), sum.Load())
+		s.Equal(input, result)
+	})
+
+	s.Run("default workers", func() {
+		var calls atomic.Int64
+
+		New([]int{1, 2, 3}).ForEachParallel(func(int) { calls.Add(1) }, 0)
+		s.Equal(int64(3), calls.Load())
+	})
+
+	s.Run("empty slice", func() {
+		var calls atomic.Int64
+
+		New([]int{}).ForEachParallel(func(int) { calls.Add(1) }, 4)
+		s.Zero(calls.Load())
+	})
+}
@@ -0,0 +1,31 @@
+package iterable
+
+// Pipe is a reusable sequence of stages that transform an Iterable. It lets a chain
+// of operations be defined once and applied to many inputs. The zero value is an
+// empty Pipe that returns its input unchanged.
+type Pipe[T comparable] struct {
+	stages []func(iter *Iterable[T]) *Iterable[T]
+}
+
+// NewPipe creates a new Pipe that runs the given stages in order.
+func NewPipe[T comparable](stages ...func(iter *Iterable[T]) *Iterable[T]) *Pipe[T] {
+	return &Pipe[T]{stages: stages}
+}
+
+// Then adds a stage to the end of the Pipe.
+// Returns the same Pipe instance to enable method chaining.
+func (p *Pipe[T]) Then(stage func(iter *Iterable[T]) *Iterable[T]) *Pipe[T] {
+	p.stages = append(p.stages, stage)
+
+	return p
+}
+
+// Apply runs every stage of the Pipe in order, passing the Iterable returned by each
+// stage to the next one, and returns the result of the last stage.
+func (p *Pipe[T]) Apply(iter *Iterable[T]) *Iterable[T] {
+	for _, stage := range p.stages {
+		iter = stage(iter)
+	}
+
+	return iter
+}
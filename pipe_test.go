@@ -0,0 +1,37 @@
+package iterable
+
+func (s *IterableSuite) TestPipe() {
+	evensReversed := NewPipe[int]().
+		Then(func(iter *Iterable[int]) *Iterable[int] {
+			return iter.Filter(func(i int) bool { return i%2 == 0 })
+		}).
+		Then((*Iterable[int]).Reverse)
+
+	s.Run("applies to several inputs", func() {
+		s.Equal([]int{6, 4, 2}, evensReversed.Apply(New([]int{1, 2, 3, 4, 5, 6})).Collect())
+		s.Equal([]int{20, 10}, evensReversed.Apply(New([]int{10, 15, 20})).Collect())
+	})
+
+	s.Run("empty input", func() {
+		s.Equal([]int{}, evensReversed.Apply(New([]int{})).Collect())
+	})
+
+	s.Run("zero value is identity", func() {
+		var pipe Pipe[int]
+		s.Equal([]int{1, 2, 3}, pipe.Apply(New([]int{1, 2, 3})).Collect())
+	})
+
+	s.Run("stages from constructor", func() {
+		pipe := NewPipe(Sort[int], (*Iterable[int]).Unique)
+		s.Equal([]int{1, 2, 3}, pipe.Apply(New([]int{3, 1, 2, 3, 1})).Collect())
+	})
+
+	s.Run("composes with package functions", func() {
+		pipe := NewPipe(func(iter *Iterable[string]) *Iterable[string] {
+			return SortBy(iter, func(s string) int { return len(s) })
+		}).Then(func(iter *Iterable[string]) *Iterable[string] {
+			return iter.Take(2)
+		})
+		s.Equal([]string{"go", "zig"}, pipe.Apply(New([]string{"rust", "go", "zig"})).Collect())
+	})
+}
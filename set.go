@@ -0,0 +1,82 @@
+package iterable
+
+import "slices"
+
+// Set is an unordered collection of distinct comparable elements backed by a map,
+// providing constant-time membership checks.
+type Set[T comparable] map[T]struct{}
+
+// NewSet creates a new Set containing the given items.
+func NewSet[T comparable](items ...T) Set[T] {
+	set := make(Set[T], len(items))
+	set.Add(items...)
+
+	return set
+}
+
+// Add inserts the given items into the set. Items already present are ignored.
+func (s Set[T]) Add(items ...T) {
+	for _, item := range items {
+		s[item] = struct{}{}
+	}
+}
+
+// Contains reports whether item is present in the set.
+func (s Set[T]) Contains(item T) bool {
+	_, ok := s[item]
+
+	return ok
+}
+
+// Union returns a new Set containing the elements present in either set.
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	union := make(Set[T], len(s)+len(other))
+	for item := range s {
+		union.Add(item)
+	}
+
+	for item := range other {
+		union.Add(item)
+	}
+
+	return union
+}
+
+// Len returns the number of elements in the set.
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// ToSet returns a Set containing the distinct elements of the collection.
+func (i *Iterable[T]) ToSet() Set[T] {
+	return NewSet(i.collection...)
+}
+
+// Union returns a new Iterable containing the distinct elements of a followed by the
+// distinct elements of b that are not in a, in the order they are first seen.
+func Union[T comparable](a, b *Iterable[T]) *Iterable[T] {
+	return Wrap(slices.Concat(a.collection, b.collection)).Unique()
+}
+
+// Intersection returns a new Iterable containing the distinct elements of a that are
+// also present in b, in the order they appear in a.
+func Intersection[T comparable](a, b *Iterable[T]) *Iterable[T] {
+	return Wrap(a.collection).Filter(b.ToSet().Contains).Unique()
+}
+
+// Difference returns a new Iterable containing the distinct elements of a that are
+// not present in b, in the order they appear in a.
+func Difference[T comparable](a, b *Iterable[T]) *Iterable[T] {
+	other := b.ToSet()
+
+	return Wrap(a.collection).Filter(func(item T) bool {
+		return !other.Contains(item)
+	}).Unique()
+}
+
+// SymmetricDifference returns a new Iterable containing the distinct elements that are
+// present in exactly one of a and b. Elements from a come first, in the order they
+// appear in a, followed by elements from b in the order they appear in b.
+func SymmetricDifference[T comparable](a, b *Iterable[T]) *Iterable[T] {
+	return Difference(a, b).Concat(Difference(b, a))
+}
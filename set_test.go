@@ -0,0 +1,153 @@
+package iterable
+
+func (s *IterableSuite) TestToSet() {
+	tests := []struct {
+		name     string
+		input    []string
+		expected Set[string]
+	}{
+		{
+			name:     "empty slice",
+			input:    []string{},
+			expected: Set[string]{},
+		},
+		{
+			name:  "duplicates collapse",
+			input: []string{"a", "b", "a", "c", "b"},
+			expected: Set[string]{
+				"a": {},
+				"b": {},
+				"c": {},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, New(tt.input).ToSet())
+		})
+	}
+
+	s.Run("usable as plain map", func() {
+		var lookup map[int]struct{} = New([]int{1, 2, 2}).ToSet()
+		s.Len(lookup, 2)
+	})
+}
+
+func (s *IterableSuite) TestSet() {
+	s.Run("add and contains", func() {
+		set := NewSet[int]()
+		s.False(set.Contains(1))
+
+		set.Add(1, 2, 2)
+		s.True(set.Contains(1))
+		s.True(set.Contains(2))
+		s.False(set.Contains(3))
+		s.Equal(2, set.Len())
+	})
+
+	s.Run("union", func() {
+		a := NewSet(1, 2, 3)
+		b := NewSet(3, 4)
+
+		union := a.Union(b)
+		s.Equal(NewSet(1, 2, 3, 4), union)
+		s.Equal(NewSet(1, 2, 3), a, "union should not modify the receiver")
+		s.Equal(NewSet(3, 4), b, "union should not modify the argument")
+	})
+}
+
+func (s *IterableSuite) TestSetAlgebra() {
+	tests := []struct {
+		name         string
+		a            []int
+		b            []int
+		union        []int
+		intersection []int
+		difference   []int
+	}{
+		{
+			name:         "both empty",
+			a:            []int{},
+			b:            []int{},
+			union:        []int{},
+			intersection: []int{},
+			difference:   []int{},
+		},
+		{
+			name:         "overlapping",
+			a:            []int{1, 2, 3, 2},
+			b:            []int{4, 3, 2, 5},
+			union:        []int{1, 2, 3, 4, 5},
+			intersection: []int{2, 3},
+			difference:   []int{1},
+		},
+		{
+			name:         "disjoint",
+			a:            []int{1, 2},
+			b:            []int{3, 4},
+			union:        []int{1, 2, 3, 4},
+			intersection: []int{},
+			difference:   []int{1, 2},
+		},
+		{
+			name:         "identical",
+			a:            []int{1, 2, 3},
+			b:            []int{1, 2, 3},
+			union:        []int{1, 2, 3},
+			intersection: []int{1, 2, 3},
+			difference:   []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			a, b := New(tt.a), New(tt.b)
+			s.Equal(tt.union, Union(a, b).Collect())
+			s.Equal(tt.intersection, Intersection(a, b).Collect())
+			s.Equal(tt.difference, Difference(a, b).Collect())
+			s.Equal(tt.a, a.Collect(), "inputs should not be modified")
+			s.Equal(tt.b, b.Collect(), "inputs should not be modified")
+		})
+	}
+}
+
+func (s *IterableSuite) TestSymmetricDifference() {
+	tests := []struct {
+		name     string
+		a        []int
+		b        []int
+		expected []int
+	}{
+		{
+			name:     "both empty",
+			a:        []int{},
+			b:        []int{},
+			expected: []int{},
+		},
+		{
+			name:     "fully disjoint",
+			a:        []int{1, 2, 1},
+			b:        []int{4, 3, 4},
+			expected: []int{1, 2, 4, 3},
+		},
+		{
+			name:     "fully overlapping",
+			a:        []int{1, 2, 3},
+			b:        []int{3, 2, 1, 1},
+			expected: []int{},
+		},
+		{
+			name:     "partially overlapping",
+			a:        []int{1, 2, 3},
+			b:        []int{2, 3, 4, 5},
+			expected: []int{1, 4, 5},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expected, SymmetricDifference(New(tt.a), New(tt.b)).Collect())
+		})
+	}
+}